@@ -0,0 +1,184 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Violation is a backward-incompatible difference between a snapshot and the current schema.
+type Violation struct {
+	Element string
+	Reason  string
+}
+
+func (v Violation) String() string {
+	return v.Element + ": " + v.Reason
+}
+
+// Compare reports changes in current that break consumers built against previous.
+// Added messages, fields and enum values are compatible.
+func Compare(previous, current *descriptorpb.FileDescriptorProto) []Violation {
+	var violations []Violation
+
+	prefix := "." + previous.GetPackage()
+	currentMessages := messagesByName(prefix, current.GetMessageType())
+	for name, prev := range messagesByName(prefix, previous.GetMessageType()) {
+		cur, ok := currentMessages[name]
+		if !ok {
+			violations = append(violations, Violation{name, "message removed"})
+			continue
+		}
+		violations = append(violations, compareMessage(name, prev, cur)...)
+	}
+
+	currentEnums := enumsByName(prefix, current.GetEnumType(), current.GetMessageType())
+	for name, prev := range enumsByName(prefix, previous.GetEnumType(), previous.GetMessageType()) {
+		cur, ok := currentEnums[name]
+		if !ok {
+			violations = append(violations, Violation{name, "enum removed"})
+			continue
+		}
+		violations = append(violations, compareEnum(name, prev, cur)...)
+	}
+
+	slices.SortFunc(violations, func(a, b Violation) int {
+		if c := cmp.Compare(a.Element, b.Element); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Reason, b.Reason)
+	})
+
+	return violations
+}
+
+func compareMessage(name string, prev, cur *descriptorpb.DescriptorProto) []Violation {
+	var violations []Violation
+
+	currentFields := make(map[int32]*descriptorpb.FieldDescriptorProto, len(cur.GetField()))
+	for _, field := range cur.GetField() {
+		currentFields[field.GetNumber()] = field
+	}
+
+	for _, prevField := range prev.GetField() {
+		element := fmt.Sprintf("%s.%s", name, prevField.GetName())
+
+		curField, ok := currentFields[prevField.GetNumber()]
+		if !ok {
+			violations = append(violations, Violation{element,
+				fmt.Sprintf("field %d removed", prevField.GetNumber())})
+			continue
+		}
+
+		if curField.GetName() != prevField.GetName() {
+			// JSON encoding uses names, so a rename breaks JSON consumers
+			// and usually means the tag got reused for another meaning.
+			violations = append(violations, Violation{element,
+				fmt.Sprintf("tag %d reused by field %q", prevField.GetNumber(), curField.GetName())})
+		}
+
+		if curField.GetType() != prevField.GetType() || curField.GetTypeName() != prevField.GetTypeName() {
+			violations = append(violations, Violation{element,
+				fmt.Sprintf("type changed from %s to %s", fieldType(prevField), fieldType(curField))})
+		}
+
+		if curField.GetLabel() != prevField.GetLabel() {
+			violations = append(violations, Violation{element,
+				fmt.Sprintf("label changed from %s to %s", prevField.GetLabel(), curField.GetLabel())})
+		}
+
+		if oneofName(prev, prevField) != oneofName(cur, curField) {
+			violations = append(violations, Violation{element, "moved into or out of oneof"})
+		}
+	}
+
+	for _, curField := range cur.GetField() {
+		if isReserved(prev, curField.GetNumber()) {
+			violations = append(violations, Violation{fmt.Sprintf("%s.%s", name, curField.GetName()),
+				fmt.Sprintf("uses reserved tag %d", curField.GetNumber())})
+		}
+	}
+
+	return violations
+}
+
+func compareEnum(name string, prev, cur *descriptorpb.EnumDescriptorProto) []Violation {
+	var violations []Violation
+
+	currentValues := make(map[int32]string, len(cur.GetValue()))
+	for _, value := range cur.GetValue() {
+		currentValues[value.GetNumber()] = value.GetName()
+	}
+
+	for _, value := range prev.GetValue() {
+		element := fmt.Sprintf("%s.%s", name, value.GetName())
+		curName, ok := currentValues[value.GetNumber()]
+		switch {
+		case !ok:
+			violations = append(violations, Violation{element,
+				fmt.Sprintf("value %d removed", value.GetNumber())})
+		case curName != value.GetName():
+			violations = append(violations, Violation{element,
+				fmt.Sprintf("value %d reused by %q", value.GetNumber(), curName)})
+		}
+	}
+
+	return violations
+}
+
+func messagesByName(prefix string,
+	messages []*descriptorpb.DescriptorProto) map[string]*descriptorpb.DescriptorProto {
+
+	result := make(map[string]*descriptorpb.DescriptorProto)
+	for _, msg := range messages {
+		name := prefix + "." + msg.GetName()
+		result[name] = msg
+		for nestedName, nested := range messagesByName(name, msg.GetNestedType()) {
+			result[nestedName] = nested
+		}
+	}
+	return result
+}
+
+func enumsByName(prefix string, enums []*descriptorpb.EnumDescriptorProto,
+	messages []*descriptorpb.DescriptorProto) map[string]*descriptorpb.EnumDescriptorProto {
+
+	result := make(map[string]*descriptorpb.EnumDescriptorProto)
+	for _, enum := range enums {
+		result[prefix+"."+enum.GetName()] = enum
+	}
+	for _, msg := range messages {
+		name := prefix + "." + msg.GetName()
+		for nestedName, nested := range enumsByName(name, msg.GetEnumType(), msg.GetNestedType()) {
+			result[nestedName] = nested
+		}
+	}
+	return result
+}
+
+func fieldType(field *descriptorpb.FieldDescriptorProto) string {
+	if field.GetTypeName() != "" {
+		return field.GetTypeName()
+	}
+	return field.GetType().String()
+}
+
+// oneofName returns the oneof the field belongs to, ignoring synthetic oneofs of proto3 optional.
+func oneofName(msg *descriptorpb.DescriptorProto, field *descriptorpb.FieldDescriptorProto) string {
+	if field.OneofIndex == nil || field.GetProto3Optional() {
+		return ""
+	}
+	return msg.GetOneofDecl()[field.GetOneofIndex()].GetName()
+}
+
+func isReserved(msg *descriptorpb.DescriptorProto, number int32) bool {
+	for _, r := range msg.GetReservedRange() {
+		// Reserved range end is exclusive.
+		if number >= r.GetStart() && number < r.GetEnd() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// testSchema returns a contract with a plain, a repeated, a message and a oneof
+// field, a reserved range and enums on both levels.
+func testSchema() *descriptorpb.FileDescriptorProto {
+	return &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("events.proto"),
+		Package: proto.String("events.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("OrderCreated"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("order_id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+					repeated(field("items", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+					message("created_at", 3, ".google.protobuf.Timestamp"),
+					inOneof(field("comment", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING), 0),
+				},
+				OneofDecl: []*descriptorpb.OneofDescriptorProto{
+					{Name: proto.String("details")},
+				},
+				ReservedRange: []*descriptorpb.DescriptorProto_ReservedRange{
+					// Reserved 10 to 11.
+					{Start: proto.Int32(10), End: proto.Int32(12)},
+				},
+				EnumType: []*descriptorpb.EnumDescriptorProto{
+					enum("Source", "SOURCE_UNSPECIFIED", "SOURCE_APP"),
+				},
+			},
+		},
+		EnumType: []*descriptorpb.EnumDescriptorProto{
+			enum("OrderStatus", "ORDER_STATUS_UNSPECIFIED", "ORDER_STATUS_CREATED", "ORDER_STATUS_PAID"),
+		},
+	}
+}
+
+func field(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(number),
+		Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:   typ.Enum(),
+	}
+}
+
+func message(name string, number int32, typeName string) *descriptorpb.FieldDescriptorProto {
+	f := field(name, number, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE)
+	f.TypeName = proto.String(typeName)
+	return f
+}
+
+func repeated(f *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+	f.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	return f
+}
+
+func inOneof(f *descriptorpb.FieldDescriptorProto, index int32) *descriptorpb.FieldDescriptorProto {
+	f.OneofIndex = proto.Int32(index)
+	return f
+}
+
+func enum(name string, values ...string) *descriptorpb.EnumDescriptorProto {
+	e := &descriptorpb.EnumDescriptorProto{Name: proto.String(name)}
+	for i, value := range values {
+		e.Value = append(e.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:   proto.String(value),
+			Number: proto.Int32(int32(i)),
+		})
+	}
+	return e
+}
+
+func TestCompare(t *testing.T) {
+	const msg = ".events.v1.OrderCreated"
+
+	tests := []struct {
+		name   string
+		change func(file *descriptorpb.FileDescriptorProto)
+		want   []Violation
+	}{
+		{
+			name:   "unchanged",
+			change: func(file *descriptorpb.FileDescriptorProto) {},
+		},
+		{
+			name: "field added",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				m := file.MessageType[0]
+				m.Field = append(m.Field, field("customer_id", 5, descriptorpb.FieldDescriptorProto_TYPE_STRING))
+			},
+		},
+		{
+			name: "field added to oneof",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				m := file.MessageType[0]
+				m.Field = append(m.Field, inOneof(field("promo", 5, descriptorpb.FieldDescriptorProto_TYPE_STRING), 0))
+			},
+		},
+		{
+			name: "field added after reserved range",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				m := file.MessageType[0]
+				m.Field = append(m.Field, field("total", 12, descriptorpb.FieldDescriptorProto_TYPE_INT64))
+			},
+		},
+		{
+			name: "message added",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				file.MessageType = append(file.MessageType, &descriptorpb.DescriptorProto{
+					Name: proto.String("OrderPaid"),
+				})
+			},
+		},
+		{
+			name: "enum value added",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				e := file.EnumType[0]
+				e.Value = append(e.Value, &descriptorpb.EnumValueDescriptorProto{
+					Name:   proto.String("ORDER_STATUS_DELIVERED"),
+					Number: proto.Int32(3),
+				})
+			},
+		},
+		{
+			name: "field made proto3 optional",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				m := file.MessageType[0]
+				m.OneofDecl = append(m.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String("_order_id")})
+				m.Field[0].OneofIndex = proto.Int32(1)
+				m.Field[0].Proto3Optional = proto.Bool(true)
+			},
+		},
+		{
+			name: "field removed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				m := file.MessageType[0]
+				m.Field = slices.Delete(m.Field, 1, 2)
+			},
+			want: []Violation{{msg + ".items", "field 2 removed"}},
+		},
+		{
+			name: "scalar type changed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				file.MessageType[0].Field[0].Type = descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()
+			},
+			want: []Violation{{msg + ".order_id", "type changed from TYPE_STRING to TYPE_INT64"}},
+		},
+		{
+			name: "message type changed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				file.MessageType[0].Field[2].TypeName = proto.String(".google.protobuf.Duration")
+			},
+			want: []Violation{{msg + ".created_at",
+				"type changed from .google.protobuf.Timestamp to .google.protobuf.Duration"}},
+		},
+		{
+			name: "label changed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				file.MessageType[0].Field[1].Label = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+			},
+			want: []Violation{{msg + ".items", "label changed from LABEL_REPEATED to LABEL_OPTIONAL"}},
+		},
+		{
+			name: "tag reused under another name",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				file.MessageType[0].Field[0].Name = proto.String("external_id")
+			},
+			want: []Violation{{msg + ".order_id", `tag 1 reused by field "external_id"`}},
+		},
+		{
+			name: "field removed and tag reused with another type",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				file.MessageType[0].Field[1] = field("items_count", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32)
+			},
+			want: []Violation{
+				{msg + ".items", "label changed from LABEL_REPEATED to LABEL_OPTIONAL"},
+				{msg + ".items", `tag 2 reused by field "items_count"`},
+				{msg + ".items", "type changed from TYPE_STRING to TYPE_INT32"},
+			},
+		},
+		{
+			name: "field moved into oneof",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				inOneof(file.MessageType[0].Field[0], 0)
+			},
+			want: []Violation{{msg + ".order_id", "moved into or out of oneof"}},
+		},
+		{
+			name: "field moved out of oneof",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				file.MessageType[0].Field[3].OneofIndex = nil
+			},
+			want: []Violation{{msg + ".comment", "moved into or out of oneof"}},
+		},
+		{
+			name: "field moved to another oneof",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				m := file.MessageType[0]
+				m.OneofDecl = append(m.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String("extra")})
+				m.Field[3].OneofIndex = proto.Int32(1)
+			},
+			want: []Violation{{msg + ".comment", "moved into or out of oneof"}},
+		},
+		{
+			name: "reserved tag used",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				m := file.MessageType[0]
+				m.Field = append(m.Field,
+					field("legacy_total", 10, descriptorpb.FieldDescriptorProto_TYPE_INT64),
+					field("legacy_currency", 11, descriptorpb.FieldDescriptorProto_TYPE_STRING))
+			},
+			want: []Violation{
+				{msg + ".legacy_currency", "uses reserved tag 11"},
+				{msg + ".legacy_total", "uses reserved tag 10"},
+			},
+		},
+		{
+			name: "enum value removed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				e := file.EnumType[0]
+				e.Value = slices.Delete(e.Value, 2, 3)
+			},
+			want: []Violation{{".events.v1.OrderStatus.ORDER_STATUS_PAID", "value 2 removed"}},
+		},
+		{
+			name: "nested enum value removed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				e := file.MessageType[0].EnumType[0]
+				e.Value = slices.Delete(e.Value, 1, 2)
+			},
+			want: []Violation{{msg + ".Source.SOURCE_APP", "value 1 removed"}},
+		},
+		{
+			name: "enum value renamed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				file.EnumType[0].Value[1].Name = proto.String("ORDER_STATUS_PLACED")
+			},
+			want: []Violation{{".events.v1.OrderStatus.ORDER_STATUS_CREATED",
+				`value 1 reused by "ORDER_STATUS_PLACED"`}},
+		},
+		{
+			name: "enum removed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				file.EnumType = nil
+			},
+			want: []Violation{{".events.v1.OrderStatus", "enum removed"}},
+		},
+		{
+			name: "message removed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				file.MessageType = nil
+			},
+			want: []Violation{
+				{msg, "message removed"},
+				{msg + ".Source", "enum removed"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := testSchema()
+			tt.change(current)
+
+			got := Compare(testSchema(), current)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
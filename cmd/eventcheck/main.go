@@ -0,0 +1,104 @@
+// Command eventcheck fails when event contracts in api/proto/events change
+// in a way that breaks consumers of an earlier minor version.
+//
+// Every released minor version of the contract is stored as a descriptor
+// snapshot named <major>.<minor>.json. The current schema is compared with all
+// snapshots of the same major; a new major starts a fresh compatibility line.
+//
+//	go run ./cmd/eventcheck               # check
+//	go run ./cmd/eventcheck -write        # store snapshot of the current version
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func main() {
+	dir := flag.String("snapshots", "api/proto/events/snapshots", "directory with descriptor snapshots")
+	write := flag.Bool("write", false, "write snapshot of the current version instead of checking")
+	flag.Parse()
+
+	current := protodesc.ToFileDescriptorProto(eventspb.File_events_proto)
+	version := fmt.Sprintf("%d.%d", events.MajorVersion, events.MinorVersion)
+
+	if *write {
+		path := filepath.Join(*dir, version+".json")
+		if err := writeSnapshot(path, current); err != nil {
+			log.Fatalf("write snapshot: %v", err)
+		}
+		log.Printf("snapshot written to %s", path)
+		return
+	}
+
+	snapshots, err := filepath.Glob(filepath.Join(*dir, fmt.Sprintf("%d.*.json", events.MajorVersion)))
+	if err != nil {
+		log.Fatalf("list snapshots: %v", err)
+	}
+
+	failed := false
+	for _, path := range snapshots {
+		previous, err := readSnapshot(path)
+		if err != nil {
+			log.Fatalf("read snapshot %s: %v", path, err)
+		}
+
+		for _, v := range Compare(previous, current) {
+			fmt.Printf("%s: %s\n", filepath.Base(path), v)
+			failed = true
+		}
+	}
+
+	if failed {
+		fmt.Printf("event contracts v%s are not backward compatible, "+
+			"bump events.MajorVersion for breaking changes\n", version)
+		os.Exit(1)
+	}
+
+	fmt.Printf("event contracts v%s are compatible with %d snapshot(s)\n", version, len(snapshots))
+}
+
+func readSnapshot(path string) (*descriptorpb.FileDescriptorProto, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &descriptorpb.FileDescriptorProto{}
+	if err := protojson.Unmarshal(data, file); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func writeSnapshot(path string, file *descriptorpb.FileDescriptorProto) error {
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("%s already exists, released versions are immutable", path)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, []byte(strings.TrimSpace(string(data))+"\n"), 0o644)
+}
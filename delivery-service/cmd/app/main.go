@@ -0,0 +1,171 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/adapter/db/postgres"
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/adapter/kafka"
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/adapter/restaurant"
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/app"
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/app/database"
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/app/logger"
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/config"
+	deliveryGrpc "github.com/Wuchinator/food-delivery/delivery-service/internal/handler/grpc"
+	kafkaHandler "github.com/Wuchinator/food-delivery/delivery-service/internal/handler/kafka"
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/usecase"
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/worker"
+	pb "github.com/Wuchinator/food-delivery/delivery-service/pkg/delivery_v1"
+	"github.com/Wuchinator/food-delivery/pkg/consumer"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/Wuchinator/food-delivery/pkg/outbox"
+	"github.com/Wuchinator/food-delivery/pkg/outbox/pgstore"
+	restaurantpb "github.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal("Failed to load config", err)
+	}
+
+	log, err := logger.NewLogger(cfg.LoggerLevel, cfg.Environment)
+	if err != nil {
+		log.Fatal("Failed to init logger")
+	}
+	defer log.Sync()
+	log = logger.WithService(log, "delivery-service")
+	log.Info("Starting delivery service", zap.String("environment", cfg.Environment), zap.String("grpc port", cfg.GRPCPort))
+
+	db, err := database.NewConn(database.Config{
+		DSN:          cfg.Postgres.PostgresDSN(),
+		MaxOpenConns: cfg.Postgres.MaxOpenConns,
+		MaxIdleConns: cfg.Postgres.MaxIdleConns,
+		Timeout:      cfg.Postgres.MaxConnLifeTime,
+	}, log)
+
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+
+	defer db.Close()
+
+	restaurantConn, err := grpc.NewClient(cfg.Restaurant.Addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("Failed to create restaurant service client", zap.Error(err))
+	}
+
+	defer restaurantConn.Close()
+
+	locator := restaurant.NewLocator(restaurantpb.NewRestaurantServiceClient(restaurantConn),
+		cfg.Restaurant.Timeout, log)
+
+	courierRepo := postgres.NewCourierRepository(db.Pool, log)
+	jobRepo := postgres.NewJobRepository(db.Pool, log)
+	destinationRepo := postgres.NewDestinationRepository(db.Pool, log)
+
+	dispatchUC := usecase.NewDispatchUseCase(courierRepo, jobRepo, usecase.DispatchConfig{
+		MaxDistance: cfg.Dispatch.MaxDistance,
+		LocationTTL: cfg.Dispatch.LocationTTL,
+	}, log)
+
+	retry := consumer.RetryPolicy{
+		MaxAttempts:    cfg.Kafka.MaxAttempts,
+		InitialBackoff: cfg.Kafka.InitialBackoff,
+		MaxBackoff:     cfg.Kafka.MaxBackoff,
+	}
+	pool := consumer.PoolConfig{
+		Workers:        cfg.Kafka.Workers,
+		QueueSize:      cfg.Kafka.QueueSize,
+		CommitInterval: cfg.Kafka.CommitInterval,
+		DrainTimeout:   cfg.Kafka.DrainTimeout,
+	}
+
+	orderConsumer := consumer.NewConsumer(consumer.Config{
+		Brokers:  cfg.Kafka.Brokers,
+		Topic:    cfg.Kafka.OrdersTopic,
+		GroupID:  cfg.Kafka.OrdersGroupID,
+		TimeOut:  cfg.Kafka.TimeOut,
+		DLQTopic: cfg.Kafka.OrdersDLQTopic,
+		Retry:    retry,
+		Pool:     pool,
+
+		MetricsNamespace: "delivery_consumer",
+	}, kafkaHandler.NewOrderHandler(destinationRepo, log), log)
+
+	defer orderConsumer.Close()
+
+	kitchenConsumer := consumer.NewConsumer(consumer.Config{
+		Brokers:  cfg.Kafka.Brokers,
+		Topic:    cfg.Kafka.KitchenStatusTopic,
+		GroupID:  cfg.Kafka.KitchenStatusGroupID,
+		TimeOut:  cfg.Kafka.TimeOut,
+		DLQTopic: cfg.Kafka.KitchenStatusDLQTopic,
+		Retry:    retry,
+		Pool:     pool,
+
+		MetricsNamespace: "delivery_consumer",
+	}, kafkaHandler.NewKitchenHandler(
+		usecase.NewCreateJobUseCase(destinationRepo, jobRepo, locator, dispatchUC, log),
+		log), log)
+
+	defer kitchenConsumer.Close()
+
+	producer := kafka.NewProducer(kafka.ProducerConfig{
+		Brokers:      cfg.Kafka.Brokers,
+		StatusTopic:  cfg.Kafka.StatusTopic,
+		WriteTimeout: cfg.Kafka.TimeOut,
+	}, log)
+
+	defer producer.Close()
+
+	// Pickup and delivery reach order-service through the outbox, the order
+	// and its watchers depend on them to finish.
+	outboxRelay := outbox.NewRelay(
+		pgstore.New(db.Pool, log),
+		producer,
+		outbox.RelayConfig{
+			PollInterval:     cfg.Outbox.PollInterval,
+			BatchSize:        cfg.Outbox.BatchSize,
+			MetricsNamespace: "delivery",
+		}, log)
+
+	dispatcher := worker.NewDispatcher(dispatchUC, worker.DispatcherConfig{
+		PollInterval: cfg.Dispatch.PollInterval,
+		BatchSize:    cfg.Dispatch.BatchSize,
+	}, log)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainStreamInterceptor(
+			grpc_prometheus.StreamServerInterceptor,
+			deliveryGrpc.ErrorStreamInterceptor(log),
+		),
+		grpc.ChainUnaryInterceptor(
+			grpc_prometheus.UnaryServerInterceptor,
+			deliveryGrpc.ErrorUnaryInterceptor(log),
+		),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: 5 * time.Minute,
+			Timeout:           20 * time.Second,
+		}),
+	)
+
+	grpc_prometheus.Register(grpcServer)
+
+	pb.RegisterCourierServiceServer(grpcServer, deliveryGrpc.NewCourierServer(courierRepo,
+		usecase.NewShiftUseCase(courierRepo, log),
+		usecase.NewUpdateLocationUseCase(courierRepo, log), log))
+	pb.RegisterDeliveryServiceServer(grpcServer, deliveryGrpc.NewDeliveryServer(jobRepo,
+		usecase.NewJobProgressUseCase(jobRepo, events.NewEncoder(cfg.Kafka.EventFormat), log), log))
+	reflection.Register(grpcServer)
+
+	App := app.NewApp(cfg, log, grpcServer, outboxRelay, orderConsumer, kitchenConsumer, dispatcher)
+	App.Run()
+}
@@ -0,0 +1,248 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const uniqueViolationCode = "23505"
+
+type CourierRepository struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+}
+
+func NewCourierRepository(pool *pgxpool.Pool, logger *zap.Logger) *CourierRepository {
+	return &CourierRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+func (r *CourierRepository) Create(ctx context.Context, courier *domain.Courier) (int64, error) {
+	query := `INSERT INTO couriers (name, phone, status, created_at)
+	 VALUES ($1, $2, $3, $4)
+	 RETURNING id`
+
+	var id int64
+	err := r.pool.QueryRow(ctx, query,
+		courier.Name, courier.Phone, courier.Status, courier.CreatedAt).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return 0, domain.ErrCourierExists
+		}
+		r.logger.Error("Failed to insert courier", zap.Error(err))
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *CourierRepository) GetByID(ctx context.Context, id int64) (*domain.Courier, error) {
+	query := `SELECT id, name, phone, status, lat, lng, location_updated_at, created_at
+	 FROM couriers
+	 WHERE id = $1`
+
+	rows, err := r.pool.Query(ctx, query, id)
+	if err != nil {
+		r.logger.Error("Failed to select courier", zap.Int64("courier_id", id), zap.Error(err))
+		return nil, err
+	}
+
+	courier, err := pgx.CollectExactlyOneRow(rows, scanCourier)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrCourierNotFound
+		}
+		r.logger.Error("Failed to scan courier", zap.Int64("courier_id", id), zap.Error(err))
+		return nil, err
+	}
+
+	return &courier, nil
+}
+
+func (r *CourierRepository) StartShift(ctx context.Context,
+	courierID int64, location geo.Point, at time.Time) (*domain.Shift, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	status, err := lockCourier(ctx, tx, courierID)
+	if err != nil {
+		if !errors.Is(err, domain.ErrCourierNotFound) {
+			r.logger.Error("Failed to lock courier", zap.Int64("courier_id", courierID), zap.Error(err))
+		}
+		return nil, err
+	}
+	if status != domain.CourierOffline {
+		return nil, domain.ErrCourierOnShift
+	}
+
+	shift := &domain.Shift{CourierID: courierID, StartedAt: at}
+	err = tx.QueryRow(ctx,
+		`INSERT INTO courier_shifts (courier_id, started_at) VALUES ($1, $2) RETURNING id`,
+		courierID, at).Scan(&shift.ID)
+	if err != nil {
+		r.logger.Error("Failed to insert shift", zap.Int64("courier_id", courierID), zap.Error(err))
+		return nil, err
+	}
+
+	query := `UPDATE couriers
+	 SET status = $2, lat = $3, lng = $4, location_updated_at = $5
+	 WHERE id = $1`
+
+	_, err = tx.Exec(ctx, query, courierID, domain.CourierAvailable, location.Lat, location.Lng, at)
+	if err != nil {
+		r.logger.Error("Failed to update courier", zap.Int64("courier_id", courierID), zap.Error(err))
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("Failed to commit transaction", zap.Error(err))
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+func (r *CourierRepository) EndShift(ctx context.Context, courierID int64, at time.Time) (*domain.Shift, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	status, err := lockCourier(ctx, tx, courierID)
+	if err != nil {
+		if !errors.Is(err, domain.ErrCourierNotFound) {
+			r.logger.Error("Failed to lock courier", zap.Int64("courier_id", courierID), zap.Error(err))
+		}
+		return nil, err
+	}
+	switch status {
+	case domain.CourierOffline:
+		return nil, domain.ErrCourierOffShift
+	case domain.CourierBusy:
+		return nil, domain.ErrCourierBusy
+	}
+
+	query := `UPDATE courier_shifts
+	 SET ended_at = $2
+	 WHERE courier_id = $1 AND ended_at IS NULL
+	 RETURNING id, started_at`
+
+	shift := &domain.Shift{CourierID: courierID, EndedAt: at}
+	err = tx.QueryRow(ctx, query, courierID, at).Scan(&shift.ID, &shift.StartedAt)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		r.logger.Error("Failed to close shift", zap.Int64("courier_id", courierID), zap.Error(err))
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE couriers SET status = $2 WHERE id = $1`, courierID, domain.CourierOffline)
+	if err != nil {
+		r.logger.Error("Failed to update courier", zap.Int64("courier_id", courierID), zap.Error(err))
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("Failed to commit transaction", zap.Error(err))
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+func (r *CourierRepository) UpdateLocation(ctx context.Context, update domain.LocationUpdate) error {
+	// Updates may arrive out of order, an older one never overwrites a newer one.
+	query := `UPDATE couriers
+	 SET lat = $2, lng = $3, location_updated_at = $4
+	 WHERE id = $1 AND status <> $5
+	   AND (location_updated_at IS NULL OR location_updated_at < $4)`
+
+	tag, err := r.pool.Exec(ctx, query, update.CourierID,
+		update.Location.Lat, update.Location.Lng, update.RecordedAt, domain.CourierOffline)
+	if err != nil {
+		r.logger.Error("Failed to update courier location", zap.Int64("courier_id", update.CourierID), zap.Error(err))
+		return err
+	}
+
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	courier, err := r.GetByID(ctx, update.CourierID)
+	if err != nil {
+		return err
+	}
+	if courier.Status == domain.CourierOffline {
+		return domain.ErrCourierOffShift
+	}
+	return domain.ErrStaleLocation
+}
+
+func (r *CourierRepository) ListAvailable(ctx context.Context, since time.Time) ([]domain.Courier, error) {
+	query := `SELECT id, name, phone, status, lat, lng, location_updated_at, created_at
+	 FROM couriers
+	 WHERE status = $1 AND location_updated_at >= $2`
+
+	rows, err := r.pool.Query(ctx, query, domain.CourierAvailable, since)
+	if err != nil {
+		r.logger.Error("Failed to select available couriers", zap.Error(err))
+		return nil, err
+	}
+
+	couriers, err := pgx.CollectRows(rows, scanCourier)
+	if err != nil {
+		r.logger.Error("Failed to scan available couriers", zap.Error(err))
+		return nil, err
+	}
+
+	return couriers, nil
+}
+
+// lockCourier locks the courier row until the end of tx and returns its status.
+func lockCourier(ctx context.Context, tx pgx.Tx, courierID int64) (domain.CourierStatus, error) {
+	var status domain.CourierStatus
+	err := tx.QueryRow(ctx, `SELECT status FROM couriers WHERE id = $1 FOR UPDATE`, courierID).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", domain.ErrCourierNotFound
+	}
+	return status, err
+}
+
+func scanCourier(row pgx.CollectableRow) (domain.Courier, error) {
+	var (
+		courier           domain.Courier
+		lat, lng          *float64
+		locationUpdatedAt *time.Time
+	)
+	err := row.Scan(
+		&courier.ID,
+		&courier.Name,
+		&courier.Phone,
+		&courier.Status,
+		&lat,
+		&lng,
+		&locationUpdatedAt,
+		&courier.CreatedAt,
+	)
+	if lat != nil && lng != nil && locationUpdatedAt != nil {
+		courier.Location = geo.Point{Lat: *lat, Lng: *lng}
+		courier.LocationUpdatedAt = *locationUpdatedAt
+	}
+	return courier, err
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type DestinationRepository struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+}
+
+func NewDestinationRepository(pool *pgxpool.Pool, logger *zap.Logger) *DestinationRepository {
+	return &DestinationRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+func (r *DestinationRepository) Save(ctx context.Context, destination *domain.Destination) error {
+	// Kafka may redeliver OrderCreated, the first destination wins.
+	query := `INSERT INTO order_destinations (order_id, restaurant_id, lat, lng, address, comment, created_at)
+	 VALUES ($1, $2, $3, $4, $5, $6, $7)
+	 ON CONFLICT (order_id) DO NOTHING`
+
+	_, err := r.pool.Exec(ctx, query,
+		destination.OrderID, destination.RestaurantID,
+		destination.Location.Lat, destination.Location.Lng,
+		destination.Address, destination.Comment, destination.CreatedAt)
+	if err != nil {
+		r.logger.Error("Failed to insert destination", zap.Int64("order_id", destination.OrderID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *DestinationRepository) Get(ctx context.Context, orderID int64) (*domain.Destination, error) {
+	query := `SELECT order_id, restaurant_id, lat, lng, address, comment, created_at
+	 FROM order_destinations
+	 WHERE order_id = $1`
+
+	var destination domain.Destination
+	err := r.pool.QueryRow(ctx, query, orderID).Scan(
+		&destination.OrderID,
+		&destination.RestaurantID,
+		&destination.Location.Lat,
+		&destination.Location.Lng,
+		&destination.Address,
+		&destination.Comment,
+		&destination.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrDestinationNotFound
+		}
+		r.logger.Error("Failed to select destination", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, err
+	}
+
+	return &destination, nil
+}
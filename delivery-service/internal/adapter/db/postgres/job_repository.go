@@ -0,0 +1,249 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/outbox/pgstore"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const jobColumns = `id, order_id, restaurant_id, status, courier_id,
+	 pickup_lat, pickup_lng, dropoff_lat, dropoff_lng, dropoff_address,
+	 created_at, assigned_at, picked_up_at, delivered_at, updated_at`
+
+type JobRepository struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+}
+
+func NewJobRepository(pool *pgxpool.Pool, logger *zap.Logger) *JobRepository {
+	return &JobRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+func (r *JobRepository) Create(ctx context.Context, job *domain.DeliveryJob) (int64, error) {
+	// Kafka may redeliver READY, one job per order_id.
+	query := `INSERT INTO delivery_jobs (order_id, restaurant_id, status,
+	 pickup_lat, pickup_lng, dropoff_lat, dropoff_lng, dropoff_address, created_at, updated_at)
+	 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+	 ON CONFLICT (order_id) DO NOTHING
+	 RETURNING id`
+
+	var id int64
+	err := r.pool.QueryRow(ctx, query,
+		job.OrderID, job.RestaurantID, job.Status,
+		job.Pickup.Lat, job.Pickup.Lng, job.Dropoff.Lat, job.Dropoff.Lng,
+		job.DropoffAddress, job.CreatedAt).Scan(&id)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = r.pool.QueryRow(ctx,
+			`SELECT id FROM delivery_jobs WHERE order_id = $1`, job.OrderID).Scan(&id)
+		if err != nil {
+			r.logger.Error("Failed to select existing delivery job", zap.Error(err))
+			return 0, err
+		}
+		return id, domain.ErrJobExists
+	}
+
+	if err != nil {
+		r.logger.Error("Failed to insert delivery job", zap.Int64("order_id", job.OrderID), zap.Error(err))
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *JobRepository) GetByID(ctx context.Context, id int64) (*domain.DeliveryJob, error) {
+	return r.get(ctx, `WHERE id = $1`, id)
+}
+
+func (r *JobRepository) GetByOrderID(ctx context.Context, orderID int64) (*domain.DeliveryJob, error) {
+	return r.get(ctx, `WHERE order_id = $1`, orderID)
+}
+
+func (r *JobRepository) GetActiveByCourier(ctx context.Context, courierID int64) (*domain.DeliveryJob, error) {
+	return r.get(ctx, `WHERE courier_id = $1 AND status IN ('ASSIGNED', 'PICKED_UP')
+	 ORDER BY id DESC LIMIT 1`, courierID)
+}
+
+// get loads a single job matching the where clause.
+func (r *JobRepository) get(ctx context.Context, where string, id int64) (*domain.DeliveryJob, error) {
+	rows, err := r.pool.Query(ctx, `SELECT `+jobColumns+` FROM delivery_jobs `+where, id)
+	if err != nil {
+		r.logger.Error("Failed to select delivery job", zap.Int64("id", id), zap.Error(err))
+		return nil, err
+	}
+
+	job, err := pgx.CollectExactlyOneRow(rows, scanJob)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrJobNotFound
+		}
+		r.logger.Error("Failed to scan delivery job", zap.Int64("id", id), zap.Error(err))
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (r *JobRepository) ListPending(ctx context.Context, limit int) ([]domain.DeliveryJob, error) {
+	query := `SELECT ` + jobColumns + `
+	 FROM delivery_jobs
+	 WHERE status = $1
+	 ORDER BY created_at
+	 LIMIT $2`
+
+	rows, err := r.pool.Query(ctx, query, domain.JobPending, limit)
+	if err != nil {
+		r.logger.Error("Failed to select pending delivery jobs", zap.Error(err))
+		return nil, err
+	}
+
+	jobs, err := pgx.CollectRows(rows, scanJob)
+	if err != nil {
+		r.logger.Error("Failed to scan pending delivery jobs", zap.Error(err))
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (r *JobRepository) Assign(ctx context.Context, jobID, courierID int64, at time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `UPDATE couriers SET status = $3 WHERE id = $1 AND status = $2`,
+		courierID, domain.CourierAvailable, domain.CourierBusy)
+	if err != nil {
+		r.logger.Error("Failed to reserve courier", zap.Int64("courier_id", courierID), zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrCourierUnavailable
+	}
+
+	query := `UPDATE delivery_jobs
+	 SET status = $3, courier_id = $4, assigned_at = $5, updated_at = $5
+	 WHERE id = $1 AND status = $2`
+
+	tag, err = tx.Exec(ctx, query, jobID, domain.JobPending, domain.JobAssigned, courierID, at)
+	if err != nil {
+		r.logger.Error("Failed to assign delivery job", zap.Int64("job_id", jobID), zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrJobConflict
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("Failed to commit transaction", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *JobRepository) UpdateStatus(ctx context.Context, job *domain.DeliveryJob,
+	from domain.JobStatus, events ...*domain.OutboxMessage) error {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE delivery_jobs
+	 SET status = $4, picked_up_at = $5, delivered_at = $6, updated_at = $7
+	 WHERE id = $1 AND status = $2 AND courier_id = $3`
+
+	tag, err := tx.Exec(ctx, query, job.ID, from, job.CourierID, job.Status,
+		nullTime(job.PickedUpAt), nullTime(job.DeliveredAt), job.UpdatedAt)
+	if err != nil {
+		r.logger.Error("Failed to update delivery job", zap.Int64("job_id", job.ID), zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrJobConflict
+	}
+
+	if job.Status == domain.JobDelivered {
+		_, err = tx.Exec(ctx, `UPDATE couriers SET status = $3 WHERE id = $1 AND status = $2`,
+			job.CourierID, domain.CourierBusy, domain.CourierAvailable)
+		if err != nil {
+			r.logger.Error("Failed to release courier", zap.Int64("courier_id", job.CourierID), zap.Error(err))
+			return err
+		}
+	}
+
+	for _, msg := range events {
+		if err := pgstore.Insert(ctx, tx, msg); err != nil {
+			r.logger.Error("Failed to insert outbox message", zap.Int64("job_id", job.ID), zap.Error(err))
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("Failed to commit transaction", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func scanJob(row pgx.CollectableRow) (domain.DeliveryJob, error) {
+	var (
+		job                                 domain.DeliveryJob
+		courierID                           *int64
+		assignedAt, pickedUpAt, deliveredAt *time.Time
+	)
+	err := row.Scan(
+		&job.ID,
+		&job.OrderID,
+		&job.RestaurantID,
+		&job.Status,
+		&courierID,
+		&job.Pickup.Lat,
+		&job.Pickup.Lng,
+		&job.Dropoff.Lat,
+		&job.Dropoff.Lng,
+		&job.DropoffAddress,
+		&job.CreatedAt,
+		&assignedAt,
+		&pickedUpAt,
+		&deliveredAt,
+		&job.UpdatedAt,
+	)
+	if courierID != nil {
+		job.CourierID = *courierID
+	}
+	job.AssignedAt = timeOrZero(assignedAt)
+	job.PickedUpAt = timeOrZero(pickedUpAt)
+	job.DeliveredAt = timeOrZero(deliveredAt)
+	return job, err
+}
+
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func timeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
@@ -0,0 +1,71 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/Wuchinator/food-delivery/pkg/outbox"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+type ProducerConfig struct {
+	Brokers      []string
+	StatusTopic  string
+	WriteTimeout time.Duration
+}
+
+type Producer struct {
+	writer *kafka.Writer
+	logger *zap.Logger
+}
+
+func NewProducer(cfg ProducerConfig, logger *zap.Logger) *Producer {
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Topic:        cfg.StatusTopic,
+		Balancer:     &kafka.Hash{},
+		WriteTimeout: cfg.WriteTimeout,
+		RequiredAcks: kafka.RequireAll,
+	}
+
+	return &Producer{
+		writer: writer,
+		logger: logger.Named("kafka_producer"),
+	}
+}
+
+// Publish writes an outbox message to the delivery status topic. Messages are
+// keyed by order id, so statuses of one order stay ordered within a partition.
+func (p *Producer) Publish(ctx context.Context, msg outbox.Message) error {
+	if msg.EventType != domain.EventDeliveryStatusChanged {
+		return fmt.Errorf("no topic for event type %q", msg.EventType)
+	}
+
+	err := p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(msg.Key),
+		Value: msg.Payload,
+		Headers: []kafka.Header{
+			{Key: "event_type", Value: []byte(msg.EventType)},
+			{Key: events.HeaderContentType, Value: []byte(msg.ContentType)},
+		},
+	})
+	if err != nil {
+		p.logger.Error("Failed to write message", zap.Error(err))
+		return err
+	}
+
+	p.logger.Info("Outbox message sent to Kafka",
+		zap.Int64("outbox_id", msg.ID),
+		zap.String("event_type", msg.EventType))
+
+	return nil
+}
+
+func (p *Producer) Close() error {
+	p.logger.Info("Producer close")
+	return p.writer.Close()
+}
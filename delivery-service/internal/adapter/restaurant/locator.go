@@ -0,0 +1,48 @@
+package restaurant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+	restaurantpb "github.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Locator reads restaurant locations, the pickup points of deliveries, from restaurant-service.
+type Locator struct {
+	client  restaurantpb.RestaurantServiceClient
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+func NewLocator(client restaurantpb.RestaurantServiceClient, timeout time.Duration, logger *zap.Logger) *Locator {
+	return &Locator{
+		client:  client,
+		timeout: timeout,
+		logger:  logger.Named("restaurant_locator"),
+	}
+}
+
+func (l *Locator) Locate(ctx context.Context, restaurantID int64) (geo.Point, error) {
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+
+	resp, err := l.client.GetRestaurant(ctx, &restaurantpb.GetRestaurantRequest{RestaurantId: restaurantID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return geo.Point{}, fmt.Errorf("restaurant %d: %w", restaurantID, domain.ErrRestaurantNotFound)
+		}
+		l.logger.Error("Failed to get restaurant", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return geo.Point{}, fmt.Errorf("get restaurant: %w", err)
+	}
+
+	return geo.Point{
+		Lat: resp.Restaurant.GetLat(),
+		Lng: resp.Restaurant.GetLng(),
+	}, nil
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/joho/godotenv"
+)
+
+type Config struct {
+	Environment string
+	LoggerLevel string
+	GRPCPort    string
+	MetricsPort string
+	Kafka       KafkaConfig
+	Postgres    PostgresConfig
+	Restaurant  RestaurantConfig
+	Dispatch    DispatchConfig
+	Outbox      OutboxConfig
+}
+
+type PostgresConfig struct {
+	User            string
+	Password        string
+	Host            string
+	Port            string
+	Database        string
+	MaxOpenConns    int
+	MaxIdleConns    int
+	MaxConnLifeTime time.Duration
+	SSLmode         string
+}
+
+type KafkaConfig struct {
+	Brokers []string
+	TimeOut time.Duration
+
+	// OrdersTopic carries OrderCreated with delivery addresses.
+	OrdersTopic    string
+	OrdersGroupID  string
+	OrdersDLQTopic string
+
+	// KitchenStatusTopic carries KitchenStatusChanged, READY opens a delivery job.
+	KitchenStatusTopic    string
+	KitchenStatusGroupID  string
+	KitchenStatusDLQTopic string
+
+	// StatusTopic receives DeliveryStatusChanged for order-service.
+	StatusTopic string
+	EventFormat events.Format
+
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	Workers        int
+	QueueSize      int
+	CommitInterval time.Duration
+	DrainTimeout   time.Duration
+}
+
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+type RestaurantConfig struct {
+	Addr    string
+	Timeout time.Duration
+}
+
+type DispatchConfig struct {
+	// MaxDistance is in meters, zero does not limit how far couriers are.
+	MaxDistance  float64
+	LocationTTL  time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+func Load() (*Config, error) {
+	if os.Getenv("ENVIRONMENT") != "PRODUCTION" {
+		_ = godotenv.Load()
+	}
+
+	cfg := &Config{
+		Environment: getEnv("ENVIRONMENT", "development"),
+		LoggerLevel: getEnv("LOGGER_LEVEL", "DEBUG"),
+		GRPCPort:    getEnv("GRPCPORT", "50051"),
+		MetricsPort: getEnv("METRICS_PORT", "9092"),
+	}
+
+	cfg.Postgres = PostgresConfig{
+		Host:            getEnv("POSTGRES_HOST", "postgres-deliveries"),
+		Port:            getEnv("POSTGRES_PORT", "5432"),
+		Database:        getEnv("POSTGRES_DB", "deliveries"),
+		User:            getEnv("POSTGRES_USER", "user"),
+		Password:        getEnv("POSTGRES_PASSWORD", "password"),
+		MaxOpenConns:    getEnvAsInt("POSTGRES_MAX_OPEN_CONNS", 25),
+		MaxIdleConns:    getEnvAsInt("POSTGRES_MAX_IDLE_CONNS", 5),
+		MaxConnLifeTime: getEnvAsDuration("POSTGRES_MAX_LIFE_TIME", 5*time.Minute),
+		SSLmode:         getEnv("POSTGRES_SSL_MODE", "disable"),
+	}
+
+	eventFormat, err := events.ParseFormat(getEnv("KAFKA_EVENT_FORMAT", string(events.FormatJSON)))
+	if err != nil {
+		return nil, err
+	}
+
+	brokers := getEnv("KAFKA_BROKERS", "localhost:9092")
+	cfg.Kafka = KafkaConfig{
+		Brokers: strings.Split(brokers, ","),
+		TimeOut: getEnvAsDuration("TIMEOUT", time.Second*30),
+
+		OrdersTopic:    getEnv("KAFKA_TOPIC_ORDER", "user-order"),
+		OrdersGroupID:  getEnv("KAFKA_ORDERS_GROUP_ID", "delivery-service-orders"),
+		OrdersDLQTopic: getEnv("KAFKA_ORDERS_DLQ_TOPIC", "delivery-orders.dlq"),
+
+		KitchenStatusTopic:    getEnv("KAFKA_TOPIC_KITCHEN_STATUS", "kitchen-status"),
+		KitchenStatusGroupID:  getEnv("KAFKA_KITCHEN_GROUP_ID", "delivery-service-kitchen"),
+		KitchenStatusDLQTopic: getEnv("KAFKA_KITCHEN_DLQ_TOPIC", "delivery-kitchen-status.dlq"),
+
+		StatusTopic: getEnv("KAFKA_TOPIC_DELIVERY_STATUS", "delivery-status"),
+		EventFormat: eventFormat,
+
+		MaxAttempts:    getEnvAsInt("KAFKA_MAX_ATTEMPTS", 5),
+		InitialBackoff: getEnvAsDuration("KAFKA_INITIAL_BACKOFF", 200*time.Millisecond),
+		MaxBackoff:     getEnvAsDuration("KAFKA_MAX_BACKOFF", 10*time.Second),
+
+		Workers:        getEnvAsInt("KAFKA_CONSUMER_WORKERS", 1),
+		QueueSize:      getEnvAsInt("KAFKA_CONSUMER_QUEUE_SIZE", 64),
+		CommitInterval: getEnvAsDuration("KAFKA_COMMIT_INTERVAL", time.Second),
+		DrainTimeout:   getEnvAsDuration("KAFKA_DRAIN_TIMEOUT", 10*time.Second),
+	}
+
+	cfg.Restaurant = RestaurantConfig{
+		Addr:    getEnv("RESTAURANT_SERVICE_ADDR", "restaurant-service:50051"),
+		Timeout: getEnvAsDuration("RESTAURANT_SERVICE_TIMEOUT", 3*time.Second),
+	}
+
+	cfg.Dispatch = DispatchConfig{
+		MaxDistance:  getEnvAsFloat("DISPATCH_MAX_DISTANCE_M", 10000),
+		LocationTTL:  getEnvAsDuration("DISPATCH_LOCATION_TTL", 5*time.Minute),
+		PollInterval: getEnvAsDuration("DISPATCH_POLL_INTERVAL", 10*time.Second),
+		BatchSize:    getEnvAsInt("DISPATCH_BATCH_SIZE", 50),
+	}
+
+	cfg.Outbox = OutboxConfig{
+		PollInterval: getEnvAsDuration("OUTBOX_POLL_INTERVAL", time.Second),
+		BatchSize:    getEnvAsInt("OUTBOX_BATCH_SIZE", 100),
+	}
+
+	return cfg, nil
+}
+
+func (cfg *PostgresConfig) PostgresDSN() string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
+		cfg.User,
+		cfg.Password,
+		cfg.Host,
+		cfg.Port,
+		cfg.Database,
+		cfg.SSLmode,
+	)
+}
+
+func getEnvAsDuration(Val string, defaultVal time.Duration) time.Duration {
+	strVal := os.Getenv(Val)
+	if val, err := time.ParseDuration(strVal); err == nil {
+		return val
+	}
+	return defaultVal
+}
+
+func getEnvAsFloat(Val string, defaultVal float64) float64 {
+	strValue := os.Getenv(Val)
+	if value, err := strconv.ParseFloat(strValue, 64); err == nil {
+		return value
+	}
+	return defaultVal
+}
+
+func getEnvAsInt(Val string, defaultVal int) int {
+	strValue := os.Getenv(Val)
+	if value, err := strconv.Atoi(strValue); err == nil {
+		return value
+	}
+	return defaultVal
+}
+
+func getEnv(Val, defaultVal string) string {
+	if value := os.Getenv(Val); value != "" {
+
+		return value
+	}
+
+	return defaultVal
+}
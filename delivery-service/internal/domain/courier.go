@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+)
+
+type CourierStatus string
+
+// Couriers become AVAILABLE when a shift starts, BUSY while delivering and
+// OFFLINE when the shift ends.
+const (
+	CourierOffline   CourierStatus = "OFFLINE"
+	CourierAvailable CourierStatus = "AVAILABLE"
+	CourierBusy      CourierStatus = "BUSY"
+)
+
+type Courier struct {
+	ID     int64
+	Name   string
+	Phone  string
+	Status CourierStatus
+	// Location is the last known position, valid if LocationUpdatedAt is set.
+	Location          geo.Point
+	LocationUpdatedAt time.Time
+	CreatedAt         time.Time
+}
+
+// Shift is a period the courier takes deliveries. EndedAt is zero while the shift is open.
+type Shift struct {
+	ID        int64
+	CourierID int64
+	StartedAt time.Time
+	EndedAt   time.Time
+}
+
+// LocationUpdate is a position reported by the courier app.
+type LocationUpdate struct {
+	CourierID  int64
+	Location   geo.Point
+	RecordedAt time.Time
+}
+
+type CourierRepository interface {
+	// Create returns ErrCourierExists if the phone is taken.
+	Create(ctx context.Context, courier *Courier) (int64, error)
+	// GetByID returns ErrCourierNotFound for unknown courier.
+	GetByID(ctx context.Context, id int64) (*Courier, error)
+	// StartShift opens a shift and makes the courier available at location.
+	// Returns ErrCourierOnShift if a shift is open already.
+	StartShift(ctx context.Context, courierID int64, location geo.Point, at time.Time) (*Shift, error)
+	// EndShift closes the open shift and takes the courier offline. Returns
+	// ErrCourierOffShift without an open shift and ErrCourierBusy while delivering.
+	EndShift(ctx context.Context, courierID int64, at time.Time) (*Shift, error)
+	// UpdateLocation returns ErrCourierOffShift for offline couriers and
+	// ErrStaleLocation if a newer location is stored already.
+	UpdateLocation(ctx context.Context, update LocationUpdate) error
+	// ListAvailable returns available couriers whose location is not older than since.
+	ListAvailable(ctx context.Context, since time.Time) ([]Courier, error)
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+)
+
+// Candidate is a courier that may take a job and how far it is from the pickup point.
+type Candidate struct {
+	Courier  Courier
+	Distance float64
+}
+
+// RankCouriers returns couriers not farther than maxDistance meters from
+// pickup, nearest first. Zero maxDistance does not limit the distance.
+func RankCouriers(couriers []Courier, pickup geo.Point, maxDistance float64) []Candidate {
+	candidates := make([]Candidate, 0, len(couriers))
+	for _, courier := range couriers {
+		distance := geo.DistanceMeters(courier.Location, pickup)
+		if maxDistance > 0 && distance > maxDistance {
+			continue
+		}
+		candidates = append(candidates, Candidate{Courier: courier, Distance: distance})
+	}
+
+	slices.SortStableFunc(candidates, func(a, b Candidate) int {
+		return cmp.Compare(a.Distance, b.Distance)
+	})
+	return candidates
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/Wuchinator/food-delivery/pkg/validation"
+)
+
+var (
+	ErrCourierNotFound     = errors.New("courier not found")
+	ErrCourierExists       = errors.New("courier with this phone already exists")
+	ErrCourierOnShift      = errors.New("courier is already on shift")
+	ErrCourierOffShift     = errors.New("courier is not on shift")
+	ErrCourierBusy         = errors.New("courier has an active delivery")
+	ErrCourierUnavailable  = errors.New("courier is not available")
+	ErrStaleLocation       = errors.New("a newer courier location is stored")
+	ErrDestinationNotFound = errors.New("order destination not found")
+	ErrRestaurantNotFound  = errors.New("restaurant not found")
+	ErrJobNotFound         = errors.New("delivery job not found")
+	ErrJobExists           = errors.New("delivery job already exists")
+	ErrJobConflict         = errors.New("delivery job was changed concurrently")
+	ErrJobNotAssigned      = errors.New("delivery job is not assigned to the courier")
+	ErrInvalidJobStatus    = errors.New("invalid delivery job status transition")
+	ErrInvalidArgument     = validation.ErrInvalidArgument
+)
+
+// FieldViolation describes why a single request field is invalid.
+type FieldViolation = validation.FieldViolation
+
+// ValidationError collects all invalid fields of a request. It matches
+// ErrInvalidArgument and errors of its violations.
+type ValidationError = validation.Error
+
+func NewValidationError(field, description string) *ValidationError {
+	return validation.New(field, description)
+}
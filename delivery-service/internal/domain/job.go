@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+)
+
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "PENDING"
+	JobAssigned  JobStatus = "ASSIGNED"
+	JobPickedUp  JobStatus = "PICKED_UP"
+	JobDelivered JobStatus = "DELIVERED"
+)
+
+// Jobs only move forward: PENDING -> ASSIGNED -> PICKED_UP -> DELIVERED.
+var jobTransitions = map[JobStatus]JobStatus{
+	JobPending:  JobAssigned,
+	JobAssigned: JobPickedUp,
+	JobPickedUp: JobDelivered,
+}
+
+func (s JobStatus) CanTransitionTo(to JobStatus) bool {
+	next, ok := jobTransitions[s]
+	return ok && next == to
+}
+
+// Destination is where an order goes, known from OrderCreated before the
+// kitchen has cooked it.
+type Destination struct {
+	OrderID      int64
+	RestaurantID int64
+	Location     geo.Point
+	Address      string
+	Comment      string
+	CreatedAt    time.Time
+}
+
+// DeliveryJob carries a cooked order from the restaurant to the customer.
+// CourierID is zero while the job waits for a courier.
+type DeliveryJob struct {
+	ID             int64
+	OrderID        int64
+	RestaurantID   int64
+	Status         JobStatus
+	CourierID      int64
+	Pickup         geo.Point
+	Dropoff        geo.Point
+	DropoffAddress string
+	CreatedAt      time.Time
+	AssignedAt     time.Time
+	PickedUpAt     time.Time
+	DeliveredAt    time.Time
+	UpdatedAt      time.Time
+}
+
+// Advance moves a job of the courier to the next status on their behalf.
+func (j *DeliveryJob) Advance(courierID int64, to JobStatus, now time.Time) error {
+	if j.CourierID == 0 || j.CourierID != courierID {
+		return fmt.Errorf("%w: job %d, courier %d", ErrJobNotAssigned, j.ID, courierID)
+	}
+	if !j.Status.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidJobStatus, j.Status, to)
+	}
+
+	j.Status = to
+	j.UpdatedAt = now
+	switch to {
+	case JobPickedUp:
+		j.PickedUpAt = now
+	case JobDelivered:
+		j.DeliveredAt = now
+	}
+	return nil
+}
+
+type DestinationRepository interface {
+	// Save stores the destination, a redelivered one is ignored.
+	Save(ctx context.Context, destination *Destination) error
+	// Get returns ErrDestinationNotFound if OrderCreated was not seen yet.
+	Get(ctx context.Context, orderID int64) (*Destination, error)
+}
+
+type JobRepository interface {
+	// Create returns ErrJobExists with the id of existing job if a job for
+	// the same order_id was already created.
+	Create(ctx context.Context, job *DeliveryJob) (int64, error)
+	GetByID(ctx context.Context, id int64) (*DeliveryJob, error)
+	// GetByOrderID returns ErrJobNotFound if the order has no job.
+	GetByOrderID(ctx context.Context, orderID int64) (*DeliveryJob, error)
+	// GetActiveByCourier returns the assigned or picked up job of the courier,
+	// ErrJobNotFound if there is none.
+	GetActiveByCourier(ctx context.Context, courierID int64) (*DeliveryJob, error)
+	// ListPending returns up to limit jobs waiting for a courier, oldest first.
+	ListPending(ctx context.Context, limit int) ([]DeliveryJob, error)
+	// Assign gives a pending job to an available courier and makes the courier
+	// busy in one transaction. Returns ErrJobConflict if the job is not pending
+	// anymore and ErrCourierUnavailable if the courier is not available.
+	Assign(ctx context.Context, jobID, courierID int64, at time.Time) error
+	// UpdateStatus saves the job and events if it is still in status `from`,
+	// otherwise returns ErrJobConflict. A delivered job makes its courier
+	// available again.
+	UpdateStatus(ctx context.Context, job *DeliveryJob, from JobStatus, events ...*OutboxMessage) error
+}
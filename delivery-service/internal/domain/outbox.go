@@ -0,0 +1,9 @@
+package domain
+
+import "github.com/Wuchinator/food-delivery/pkg/outbox"
+
+const EventDeliveryStatusChanged = "DeliveryStatusChanged"
+
+// OutboxMessage is an event stored in the same transaction as the job change
+// and published to Kafka later by the outbox relay.
+type OutboxMessage = outbox.Message
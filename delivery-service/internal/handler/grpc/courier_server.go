@@ -0,0 +1,198 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/usecase"
+	pb "github.com/Wuchinator/food-delivery/delivery-service/pkg/delivery_v1"
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var courierStatusToPb = map[domain.CourierStatus]pb.CourierStatus{
+	domain.CourierOffline:   pb.CourierStatus_COURIER_STATUS_OFFLINE,
+	domain.CourierAvailable: pb.CourierStatus_COURIER_STATUS_AVAILABLE,
+	domain.CourierBusy:      pb.CourierStatus_COURIER_STATUS_BUSY,
+}
+
+type CourierServer struct {
+	pb.UnimplementedCourierServiceServer
+	repo      domain.CourierRepository
+	shifts    *usecase.ShiftUseCase
+	locations *usecase.UpdateLocationUseCase
+	logger    *zap.Logger
+}
+
+func NewCourierServer(repo domain.CourierRepository, shifts *usecase.ShiftUseCase,
+	locations *usecase.UpdateLocationUseCase, logger *zap.Logger) *CourierServer {
+	return &CourierServer{
+		repo:      repo,
+		shifts:    shifts,
+		locations: locations,
+		logger:    logger,
+	}
+}
+
+func (s *CourierServer) RegisterCourier(ctx context.Context,
+	req *pb.RegisterCourierRequest) (*pb.RegisterCourierResponse, error) {
+
+	courier := &domain.Courier{
+		Name:      strings.TrimSpace(req.Name),
+		Phone:     strings.TrimSpace(req.Phone),
+		Status:    domain.CourierOffline,
+		CreatedAt: time.Now(),
+	}
+
+	violations := &domain.ValidationError{}
+	if courier.Name == "" {
+		violations.Add("name", "must not be empty")
+	}
+	if courier.Phone == "" {
+		violations.Add("phone", "must not be empty")
+	}
+	if err := violations.Err(); err != nil {
+		return nil, err
+	}
+
+	id, err := s.repo.Create(ctx, courier)
+	if err != nil {
+		return nil, err
+	}
+	courier.ID = id
+
+	s.logger.Info("Courier registered", zap.Int64("courier_id", id))
+	return &pb.RegisterCourierResponse{Courier: toPbCourier(courier)}, nil
+}
+
+func (s *CourierServer) GetCourier(ctx context.Context,
+	req *pb.GetCourierRequest) (*pb.GetCourierResponse, error) {
+
+	if req.CourierId <= 0 {
+		return nil, domain.NewValidationError("courier_id", "must be positive")
+	}
+
+	courier, err := s.repo.GetByID(ctx, req.CourierId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCourierResponse{Courier: toPbCourier(courier)}, nil
+}
+
+func (s *CourierServer) StartShift(ctx context.Context,
+	req *pb.StartShiftRequest) (*pb.StartShiftResponse, error) {
+
+	violations := &domain.ValidationError{}
+	if req.CourierId <= 0 {
+		violations.Add("courier_id", "must be positive")
+	}
+	if req.Location == nil {
+		violations.Add("location", "is required")
+	}
+	if err := violations.Err(); err != nil {
+		return nil, err
+	}
+
+	shift, err := s.shifts.Start(ctx, req.CourierId, geo.Point{Lat: req.Location.Lat, Lng: req.Location.Lng})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StartShiftResponse{Shift: toPbShift(shift)}, nil
+}
+
+func (s *CourierServer) EndShift(ctx context.Context,
+	req *pb.EndShiftRequest) (*pb.EndShiftResponse, error) {
+
+	if req.CourierId <= 0 {
+		return nil, domain.NewValidationError("courier_id", "must be positive")
+	}
+
+	shift, err := s.shifts.End(ctx, req.CourierId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EndShiftResponse{Shift: toPbShift(shift)}, nil
+}
+
+// UpdateLocation stores every update of the stream. Invalid and outdated
+// updates are counted as rejected, other errors end the stream.
+func (s *CourierServer) UpdateLocation(stream pb.CourierService_UpdateLocationServer) error {
+	var accepted, rejected int32
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			s.logger.Debug("Location stream closed",
+				zap.Int32("accepted", accepted),
+				zap.Int32("rejected", rejected))
+			return stream.SendAndClose(&pb.UpdateLocationResponse{
+				Accepted: accepted,
+				Rejected: rejected,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		update := domain.LocationUpdate{
+			CourierID: req.CourierId,
+			Location:  geo.Point{Lat: req.GetLocation().GetLat(), Lng: req.GetLocation().GetLng()},
+		}
+		if req.RecordedAt != nil {
+			update.RecordedAt = req.RecordedAt.AsTime()
+		}
+
+		err = s.locations.Exec(stream.Context(), update)
+		switch {
+		case err == nil:
+			accepted++
+		case errors.Is(err, domain.ErrInvalidArgument), errors.Is(err, domain.ErrStaleLocation):
+			s.logger.Debug("Location update rejected", zap.Int64("courier_id", req.CourierId), zap.Error(err))
+			rejected++
+		default:
+			return err
+		}
+	}
+}
+
+func toPbCourier(courier *domain.Courier) *pb.Courier {
+	result := &pb.Courier{
+		Id:     courier.ID,
+		Name:   courier.Name,
+		Phone:  courier.Phone,
+		Status: courierStatusToPb[courier.Status],
+	}
+	if !courier.LocationUpdatedAt.IsZero() {
+		result.Location = toPbLocation(courier.Location)
+		result.LocationUpdatedAt = timestamppb.New(courier.LocationUpdatedAt)
+	}
+	return result
+}
+
+func toPbShift(shift *domain.Shift) *pb.Shift {
+	return &pb.Shift{
+		Id:        shift.ID,
+		CourierId: shift.CourierID,
+		StartedAt: timestamppb.New(shift.StartedAt),
+		EndedAt:   toPbTime(shift.EndedAt),
+	}
+}
+
+func toPbLocation(point geo.Point) *pb.Location {
+	return &pb.Location{Lat: point.Lat, Lng: point.Lng}
+}
+
+// toPbTime leaves zero times unset.
+func toPbTime(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/usecase"
+	pb "github.com/Wuchinator/food-delivery/delivery-service/pkg/delivery_v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var jobStatusToPb = map[domain.JobStatus]pb.JobStatus{
+	domain.JobPending:   pb.JobStatus_JOB_STATUS_PENDING,
+	domain.JobAssigned:  pb.JobStatus_JOB_STATUS_ASSIGNED,
+	domain.JobPickedUp:  pb.JobStatus_JOB_STATUS_PICKED_UP,
+	domain.JobDelivered: pb.JobStatus_JOB_STATUS_DELIVERED,
+}
+
+type DeliveryServer struct {
+	pb.UnimplementedDeliveryServiceServer
+	repo     domain.JobRepository
+	progress *usecase.JobProgressUseCase
+	logger   *zap.Logger
+}
+
+func NewDeliveryServer(repo domain.JobRepository,
+	progress *usecase.JobProgressUseCase, logger *zap.Logger) *DeliveryServer {
+	return &DeliveryServer{
+		repo:     repo,
+		progress: progress,
+		logger:   logger,
+	}
+}
+
+func (s *DeliveryServer) GetDeliveryJob(ctx context.Context,
+	req *pb.GetDeliveryJobRequest) (*pb.GetDeliveryJobResponse, error) {
+
+	if req.OrderId <= 0 {
+		return nil, domain.NewValidationError("order_id", "must be positive")
+	}
+
+	job, err := s.repo.GetByOrderID(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetDeliveryJobResponse{Job: toPbJob(job)}, nil
+}
+
+func (s *DeliveryServer) GetCourierJob(ctx context.Context,
+	req *pb.GetCourierJobRequest) (*pb.GetCourierJobResponse, error) {
+
+	if req.CourierId <= 0 {
+		return nil, domain.NewValidationError("courier_id", "must be positive")
+	}
+
+	job, err := s.repo.GetActiveByCourier(ctx, req.CourierId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCourierJobResponse{Job: toPbJob(job)}, nil
+}
+
+func (s *DeliveryServer) PickUpOrder(ctx context.Context,
+	req *pb.PickUpOrderRequest) (*pb.PickUpOrderResponse, error) {
+
+	if err := validateJobRequest(req.JobId, req.CourierId); err != nil {
+		return nil, err
+	}
+
+	job, err := s.progress.PickUp(ctx, req.JobId, req.CourierId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PickUpOrderResponse{Job: toPbJob(job)}, nil
+}
+
+func (s *DeliveryServer) DeliverOrder(ctx context.Context,
+	req *pb.DeliverOrderRequest) (*pb.DeliverOrderResponse, error) {
+
+	if err := validateJobRequest(req.JobId, req.CourierId); err != nil {
+		return nil, err
+	}
+
+	job, err := s.progress.Deliver(ctx, req.JobId, req.CourierId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeliverOrderResponse{Job: toPbJob(job)}, nil
+}
+
+func validateJobRequest(jobID, courierID int64) error {
+	violations := &domain.ValidationError{}
+	if jobID <= 0 {
+		violations.Add("job_id", "must be positive")
+	}
+	if courierID <= 0 {
+		violations.Add("courier_id", "must be positive")
+	}
+	return violations.Err()
+}
+
+func toPbJob(job *domain.DeliveryJob) *pb.DeliveryJob {
+	return &pb.DeliveryJob{
+		Id:             job.ID,
+		OrderId:        job.OrderID,
+		RestaurantId:   job.RestaurantID,
+		Status:         jobStatusToPb[job.Status],
+		CourierId:      job.CourierID,
+		Pickup:         toPbLocation(job.Pickup),
+		Dropoff:        toPbLocation(job.Dropoff),
+		DropoffAddress: job.DropoffAddress,
+		CreatedAt:      timestamppb.New(job.CreatedAt),
+		AssignedAt:     toPbTime(job.AssignedAt),
+		PickedUpAt:     toPbTime(job.PickedUpAt),
+		DeliveredAt:    toPbTime(job.DeliveredAt),
+	}
+}
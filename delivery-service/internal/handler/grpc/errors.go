@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/grpcerr"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// errorCodes maps domain errors to gRPC codes, first match wins. Field, if set,
+// is reported as a BadRequest field violation.
+var errorCodes = []grpcerr.Mapping{
+	{Err: domain.ErrCourierNotFound, Code: codes.NotFound},
+	{Err: domain.ErrJobNotFound, Code: codes.NotFound},
+	{Err: domain.ErrInvalidArgument, Code: codes.InvalidArgument},
+	{Err: domain.ErrCourierExists, Code: codes.AlreadyExists, Field: "phone"},
+	{Err: domain.ErrCourierOnShift, Code: codes.FailedPrecondition},
+	{Err: domain.ErrCourierOffShift, Code: codes.FailedPrecondition},
+	{Err: domain.ErrCourierBusy, Code: codes.FailedPrecondition},
+	{Err: domain.ErrJobNotAssigned, Code: codes.PermissionDenied},
+	{Err: domain.ErrInvalidJobStatus, Code: codes.FailedPrecondition},
+	{Err: domain.ErrJobConflict, Code: codes.Aborted},
+}
+
+// ErrorUnaryInterceptor converts errors returned by handlers into gRPC statuses.
+func ErrorUnaryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return grpcerr.UnaryInterceptor(errorCodes, logger)
+}
+
+// ErrorStreamInterceptor is ErrorUnaryInterceptor for streaming handlers.
+func ErrorStreamInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return grpcerr.StreamInterceptor(errorCodes, logger)
+}
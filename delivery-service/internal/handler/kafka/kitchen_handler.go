@@ -0,0 +1,52 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/usecase"
+	"github.com/Wuchinator/food-delivery/pkg/consumer"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// kitchenStatusReady is the kitchen ticket status of a cooked order.
+const kitchenStatusReady = "READY"
+
+// KitchenHandler opens a delivery job when the kitchen marks an order READY.
+// A job for an order whose OrderCreated was not consumed yet is retried.
+type KitchenHandler struct {
+	createJob *usecase.CreateJobUseCase
+	logger    *zap.Logger
+}
+
+func NewKitchenHandler(createJob *usecase.CreateJobUseCase, logger *zap.Logger) *KitchenHandler {
+	return &KitchenHandler{
+		createJob: createJob,
+		logger:    logger.Named("kitchen_handler"),
+	}
+}
+
+func (h *KitchenHandler) Handle(ctx context.Context, message kafka.Message) error {
+	env, err := events.Decode(header(message, events.HeaderContentType), message.Value)
+	if err != nil {
+		h.logger.Error("Failed to decode kitchen event", zap.Error(err))
+		return consumer.Permanent(fmt.Errorf("decode kitchen event: %w", err))
+	}
+
+	event := env.GetKitchenStatusChanged()
+	if event == nil || event.Status != kitchenStatusReady {
+		return nil
+	}
+
+	ctx = events.ContextWithTrace(ctx, env.Trace)
+
+	err = h.createJob.Exec(ctx, event.OrderId, event.RestaurantId)
+	if errors.Is(err, domain.ErrRestaurantNotFound) {
+		return consumer.Permanent(err)
+	}
+	return err
+}
@@ -0,0 +1,76 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/consumer"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// OrderHandler remembers where created orders go. The kitchen event that
+// starts the delivery does not carry the address.
+type OrderHandler struct {
+	destinations domain.DestinationRepository
+	logger       *zap.Logger
+}
+
+func NewOrderHandler(destinations domain.DestinationRepository, logger *zap.Logger) *OrderHandler {
+	return &OrderHandler{
+		destinations: destinations,
+		logger:       logger.Named("order_handler"),
+	}
+}
+
+func (h *OrderHandler) Handle(ctx context.Context, message kafka.Message) error {
+	env, err := events.Decode(header(message, events.HeaderContentType), message.Value)
+	if err != nil {
+		h.logger.Error("Failed to decode order event", zap.Error(err))
+		return consumer.Permanent(fmt.Errorf("decode order event: %w", err))
+	}
+
+	event := env.GetOrderCreated()
+	if event == nil {
+		return nil
+	}
+
+	address := event.GetAddress()
+	if address == nil {
+		return consumer.Permanent(fmt.Errorf("order %d has no address", event.OrderId))
+	}
+	location := geo.Point{Lat: address.Lat, Lng: address.Lng}
+	if !location.Valid() {
+		return consumer.Permanent(fmt.Errorf("order %d address is out of range", event.OrderId))
+	}
+
+	ctx = events.ContextWithTrace(ctx, env.Trace)
+
+	err = h.destinations.Save(ctx, &domain.Destination{
+		OrderID:      event.OrderId,
+		RestaurantID: event.RestaurantId,
+		Location:     location,
+		Address:      event.DeliveryAddress,
+		Comment:      address.Comment,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("save destination: %w", err)
+	}
+
+	h.logger.Info("Order destination saved", zap.Int64("order_id", event.OrderId))
+	return nil
+}
+
+func header(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+	"go.uber.org/zap"
+)
+
+// RestaurantLocator returns where a restaurant hands orders over to couriers.
+type RestaurantLocator interface {
+	Locate(ctx context.Context, restaurantID int64) (geo.Point, error)
+}
+
+// CreateJobUseCase opens a delivery job for an order the kitchen has cooked
+// and offers it to the nearest courier. It is idempotent, kitchen events may
+// be redelivered.
+type CreateJobUseCase struct {
+	destinations domain.DestinationRepository
+	jobs         domain.JobRepository
+	locator      RestaurantLocator
+	dispatch     *DispatchUseCase
+	logger       *zap.Logger
+}
+
+func NewCreateJobUseCase(destinations domain.DestinationRepository, jobs domain.JobRepository,
+	locator RestaurantLocator, dispatch *DispatchUseCase, logger *zap.Logger) *CreateJobUseCase {
+	return &CreateJobUseCase{
+		destinations: destinations,
+		jobs:         jobs,
+		locator:      locator,
+		dispatch:     dispatch,
+		logger:       logger,
+	}
+}
+
+func (uc *CreateJobUseCase) Exec(ctx context.Context, orderID, restaurantID int64) error {
+	destination, err := uc.destinations.Get(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("get destination: %w", err)
+	}
+
+	pickup, err := uc.locator.Locate(ctx, restaurantID)
+	if err != nil {
+		return fmt.Errorf("locate restaurant: %w", err)
+	}
+
+	now := time.Now()
+	job := &domain.DeliveryJob{
+		OrderID:        orderID,
+		RestaurantID:   restaurantID,
+		Status:         domain.JobPending,
+		Pickup:         pickup,
+		Dropoff:        destination.Location,
+		DropoffAddress: destination.Address,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	job.ID, err = uc.jobs.Create(ctx, job)
+	if err != nil {
+		if errors.Is(err, domain.ErrJobExists) {
+			uc.logger.Info("Delivery job already exists",
+				zap.Int64("order_id", orderID),
+				zap.Int64("job_id", job.ID))
+			return nil
+		}
+		return fmt.Errorf("create delivery job: %w", err)
+	}
+
+	uc.logger.Info("Delivery job created", zap.Int64("order_id", orderID), zap.Int64("job_id", job.ID))
+
+	// The job is stored, a failed dispatch is retried by the dispatcher.
+	if _, err := uc.dispatch.Assign(ctx, job); err != nil {
+		uc.logger.Error("Failed to dispatch delivery job", zap.Int64("job_id", job.ID), zap.Error(err))
+	}
+
+	return nil
+}
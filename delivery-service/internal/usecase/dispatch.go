@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+type DispatchConfig struct {
+	// MaxDistance is how far in meters a courier may be from the pickup point, zero is unlimited.
+	MaxDistance float64
+	// LocationTTL is how old a courier location may be, couriers silent for longer are skipped.
+	LocationTTL time.Duration
+}
+
+// DispatchUseCase assigns delivery jobs to the nearest available couriers.
+// Jobs nobody can take stay pending until DispatchPending finds a courier.
+type DispatchUseCase struct {
+	couriers domain.CourierRepository
+	jobs     domain.JobRepository
+	cfg      DispatchConfig
+	logger   *zap.Logger
+}
+
+func NewDispatchUseCase(couriers domain.CourierRepository, jobs domain.JobRepository,
+	cfg DispatchConfig, logger *zap.Logger) *DispatchUseCase {
+	return &DispatchUseCase{
+		couriers: couriers,
+		jobs:     jobs,
+		cfg:      cfg,
+		logger:   logger,
+	}
+}
+
+// Assign gives the job to the nearest available courier. It returns false if
+// no courier took the job.
+func (uc *DispatchUseCase) Assign(ctx context.Context, job *domain.DeliveryJob) (bool, error) {
+	couriers, err := uc.available(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	courierID, err := uc.assign(ctx, job, couriers)
+	return courierID != 0, err
+}
+
+// DispatchPending assigns up to limit pending jobs, oldest first, and returns
+// how many were assigned.
+func (uc *DispatchUseCase) DispatchPending(ctx context.Context, limit int) (int, error) {
+	jobs, err := uc.jobs.ListPending(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("list pending jobs: %w", err)
+	}
+	if len(jobs) == 0 {
+		return 0, nil
+	}
+
+	couriers, err := uc.available(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	assigned := 0
+	for i := range jobs {
+		if len(couriers) == 0 {
+			break
+		}
+
+		courierID, err := uc.assign(ctx, &jobs[i], couriers)
+		if err != nil {
+			return assigned, err
+		}
+		if courierID == 0 {
+			continue
+		}
+
+		assigned++
+		couriers = slices.DeleteFunc(couriers, func(c domain.Courier) bool {
+			return c.ID == courierID
+		})
+	}
+
+	return assigned, nil
+}
+
+func (uc *DispatchUseCase) available(ctx context.Context) ([]domain.Courier, error) {
+	couriers, err := uc.couriers.ListAvailable(ctx, time.Now().Add(-uc.cfg.LocationTTL))
+	if err != nil {
+		return nil, fmt.Errorf("list available couriers: %w", err)
+	}
+	return couriers, nil
+}
+
+// assign tries candidates nearest first and returns the id of the courier
+// that took the job, zero if none did.
+func (uc *DispatchUseCase) assign(ctx context.Context, job *domain.DeliveryJob, couriers []domain.Courier) (int64, error) {
+	for _, candidate := range domain.RankCouriers(couriers, job.Pickup, uc.cfg.MaxDistance) {
+		now := time.Now()
+		err := uc.jobs.Assign(ctx, job.ID, candidate.Courier.ID, now)
+		if errors.Is(err, domain.ErrCourierUnavailable) {
+			// Taken by another job or went offline since it was listed.
+			continue
+		}
+		if errors.Is(err, domain.ErrJobConflict) {
+			uc.logger.Info("Delivery job is assigned already", zap.Int64("job_id", job.ID))
+			return 0, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("assign job: %w", err)
+		}
+
+		job.Status = domain.JobAssigned
+		job.CourierID = candidate.Courier.ID
+		job.AssignedAt = now
+		job.UpdatedAt = now
+
+		uc.logger.Info("Delivery job assigned",
+			zap.Int64("job_id", job.ID),
+			zap.Int64("order_id", job.OrderID),
+			zap.Int64("courier_id", job.CourierID),
+			zap.Float64("distance_m", candidate.Distance))
+		return job.CourierID, nil
+	}
+
+	uc.logger.Info("No courier for delivery job", zap.Int64("job_id", job.ID), zap.Int64("order_id", job.OrderID))
+	return 0, nil
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// JobProgressUseCase moves a job through pickup and delivery on behalf of its
+// courier and lets order-service know about it through the outbox.
+type JobProgressUseCase struct {
+	repo    domain.JobRepository
+	encoder *events.Encoder
+	logger  *zap.Logger
+}
+
+func NewJobProgressUseCase(repo domain.JobRepository, encoder *events.Encoder, logger *zap.Logger) *JobProgressUseCase {
+	return &JobProgressUseCase{
+		repo:    repo,
+		encoder: encoder,
+		logger:  logger,
+	}
+}
+
+// PickUp records that the courier took the order at the restaurant.
+func (uc *JobProgressUseCase) PickUp(ctx context.Context, jobID, courierID int64) (*domain.DeliveryJob, error) {
+	return uc.advance(ctx, jobID, courierID, domain.JobPickedUp)
+}
+
+// Deliver records that the courier handed the order over, the courier can take a new job.
+func (uc *JobProgressUseCase) Deliver(ctx context.Context, jobID, courierID int64) (*domain.DeliveryJob, error) {
+	return uc.advance(ctx, jobID, courierID, domain.JobDelivered)
+}
+
+func (uc *JobProgressUseCase) advance(ctx context.Context,
+	jobID, courierID int64, to domain.JobStatus) (*domain.DeliveryJob, error) {
+
+	job, err := uc.repo.GetByID(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("get delivery job: %w", err)
+	}
+
+	from := job.Status
+	if err := job.Advance(courierID, to, time.Now()); err != nil {
+		return nil, err
+	}
+
+	msg, err := uc.statusEvent(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.UpdateStatus(ctx, job, from, msg); err != nil {
+		return nil, fmt.Errorf("update delivery job: %w", err)
+	}
+
+	uc.logger.Info("Delivery job status changed",
+		zap.Int64("job_id", job.ID),
+		zap.Int64("order_id", job.OrderID),
+		zap.String("from", string(from)),
+		zap.String("to", string(to)))
+
+	return job, nil
+}
+
+// statusEvent builds DeliveryStatusChanged keyed by order id, like the other
+// events of the order.
+func (uc *JobProgressUseCase) statusEvent(ctx context.Context, job *domain.DeliveryJob) (*domain.OutboxMessage, error) {
+	env, err := events.New(ctx, &eventspb.DeliveryStatusChanged{
+		JobId:     job.ID,
+		OrderId:   job.OrderID,
+		CourierId: job.CourierID,
+		Status:    string(job.Status),
+		ChangedAt: timestamppb.New(job.UpdatedAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := uc.encoder.Encode(env)
+	if err != nil {
+		return nil, fmt.Errorf("encode delivery status: %w", err)
+	}
+
+	return &domain.OutboxMessage{
+		EventType:   domain.EventDeliveryStatusChanged,
+		Key:         strconv.FormatInt(job.OrderID, 10),
+		Payload:     payload,
+		ContentType: uc.encoder.ContentType(),
+	}, nil
+}
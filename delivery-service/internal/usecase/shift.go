@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+	"go.uber.org/zap"
+)
+
+// ShiftUseCase opens and closes courier shifts. Only couriers on shift get jobs.
+type ShiftUseCase struct {
+	repo   domain.CourierRepository
+	logger *zap.Logger
+}
+
+func NewShiftUseCase(repo domain.CourierRepository, logger *zap.Logger) *ShiftUseCase {
+	return &ShiftUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Start puts the courier on shift at location.
+func (uc *ShiftUseCase) Start(ctx context.Context, courierID int64, location geo.Point) (*domain.Shift, error) {
+	if !location.Valid() {
+		return nil, domain.NewValidationError("location", "coordinates are out of range")
+	}
+
+	shift, err := uc.repo.StartShift(ctx, courierID, location, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("start shift: %w", err)
+	}
+
+	uc.logger.Info("Shift started", zap.Int64("courier_id", courierID), zap.Int64("shift_id", shift.ID))
+	return shift, nil
+}
+
+// End takes the courier off shift. A courier has to finish the active delivery first.
+func (uc *ShiftUseCase) End(ctx context.Context, courierID int64) (*domain.Shift, error) {
+	shift, err := uc.repo.EndShift(ctx, courierID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("end shift: %w", err)
+	}
+
+	uc.logger.Info("Shift ended", zap.Int64("courier_id", courierID), zap.Int64("shift_id", shift.ID))
+	return shift, nil
+}
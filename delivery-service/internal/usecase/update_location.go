@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/delivery-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+// maxClockSkew is how far in the future a device timestamp may be.
+const maxClockSkew = time.Minute
+
+// UpdateLocationUseCase stores positions streamed by courier apps.
+type UpdateLocationUseCase struct {
+	repo   domain.CourierRepository
+	logger *zap.Logger
+}
+
+func NewUpdateLocationUseCase(repo domain.CourierRepository, logger *zap.Logger) *UpdateLocationUseCase {
+	return &UpdateLocationUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Exec returns a ValidationError for invalid updates and ErrStaleLocation for
+// updates older than the stored location. Zero RecordedAt means now.
+func (uc *UpdateLocationUseCase) Exec(ctx context.Context, update domain.LocationUpdate) error {
+	now := time.Now()
+	if update.RecordedAt.IsZero() {
+		update.RecordedAt = now
+	}
+
+	violations := &domain.ValidationError{}
+	if update.CourierID <= 0 {
+		violations.Add("courier_id", "must be positive")
+	}
+	if !update.Location.Valid() {
+		violations.Add("location", "coordinates are out of range")
+	}
+	if update.RecordedAt.After(now.Add(maxClockSkew)) {
+		violations.Add("recorded_at", "must not be in the future")
+	}
+	if err := violations.Err(); err != nil {
+		return err
+	}
+
+	err := uc.repo.UpdateLocation(ctx, update)
+	if errors.Is(err, domain.ErrStaleLocation) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("update location: %w", err)
+	}
+
+	return nil
+}
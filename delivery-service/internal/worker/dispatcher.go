@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	jobsDispatched = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "delivery_jobs_dispatched_total",
+		Help: "Number of pending delivery jobs assigned by the dispatcher.",
+	})
+	dispatchErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "delivery_dispatch_errors_total",
+		Help: "Number of failed attempts to dispatch pending delivery jobs.",
+	})
+)
+
+// JobDispatcher assigns up to limit pending jobs and returns how many were assigned.
+type JobDispatcher interface {
+	DispatchPending(ctx context.Context, limit int) (int, error)
+}
+
+type DispatcherConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// Dispatcher retries jobs no courier was free for when the kitchen finished
+// them. Couriers coming on shift or delivering an order pick them up on the
+// next poll.
+type Dispatcher struct {
+	dispatcher JobDispatcher
+	cfg        DispatcherConfig
+	logger     *zap.Logger
+}
+
+func NewDispatcher(dispatcher JobDispatcher, cfg DispatcherConfig, logger *zap.Logger) *Dispatcher {
+	return &Dispatcher{
+		dispatcher: dispatcher,
+		cfg:        cfg,
+		logger:     logger.Named("dispatcher"),
+	}
+}
+
+func (d *Dispatcher) Run(ctx context.Context) {
+	d.logger.Info("Dispatcher has been started")
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for d.dispatch(ctx) == d.cfg.BatchSize {
+			if ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			d.logger.Info("Dispatcher stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context) int {
+	assigned, err := d.dispatcher.DispatchPending(ctx, d.cfg.BatchSize)
+	jobsDispatched.Add(float64(assigned))
+	if err != nil {
+		if ctx.Err() == nil {
+			dispatchErrors.Inc()
+			d.logger.Error("Failed to dispatch pending jobs", zap.Int("assigned", assigned), zap.Error(err))
+		}
+		return 0
+	}
+
+	return assigned
+}
@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS couriers (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    phone TEXT NOT NULL UNIQUE,
+    status TEXT NOT NULL DEFAULT 'OFFLINE',
+    lat DOUBLE PRECISION,
+    lng DOUBLE PRECISION,
+    location_updated_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS courier_shifts (
+    id BIGSERIAL PRIMARY KEY,
+    courier_id BIGINT NOT NULL REFERENCES couriers(id),
+    started_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP
+);
+
+-- Destinations come from OrderCreated and wait for the kitchen to finish the order.
+CREATE TABLE IF NOT EXISTS order_destinations (
+    order_id BIGINT PRIMARY KEY,
+    restaurant_id BIGINT NOT NULL,
+    lat DOUBLE PRECISION NOT NULL,
+    lng DOUBLE PRECISION NOT NULL,
+    address TEXT NOT NULL DEFAULT '',
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS delivery_jobs (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL UNIQUE,
+    restaurant_id BIGINT NOT NULL,
+    status TEXT NOT NULL,
+    courier_id BIGINT REFERENCES couriers(id),
+    pickup_lat DOUBLE PRECISION NOT NULL,
+    pickup_lng DOUBLE PRECISION NOT NULL,
+    dropoff_lat DOUBLE PRECISION NOT NULL,
+    dropoff_lng DOUBLE PRECISION NOT NULL,
+    dropoff_address TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    assigned_at TIMESTAMP,
+    picked_up_at TIMESTAMP,
+    delivered_at TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One open shift per courier.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_courier_shifts_open ON courier_shifts (courier_id) WHERE ended_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_couriers_status ON couriers (status);
+CREATE INDEX IF NOT EXISTS idx_delivery_jobs_pending ON delivery_jobs (created_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_delivery_jobs_courier_id_status ON delivery_jobs (courier_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS delivery_jobs;
+DROP TABLE IF EXISTS order_destinations;
+DROP TABLE IF EXISTS courier_shifts;
+DROP TABLE IF EXISTS couriers;
+-- +goose StatementEnd
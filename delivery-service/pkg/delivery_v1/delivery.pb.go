@@ -0,0 +1,1549 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: delivery.proto
+
+package delivery_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CourierStatus int32
+
+const (
+	CourierStatus_COURIER_STATUS_UNSPECIFIED CourierStatus = 0
+	CourierStatus_COURIER_STATUS_OFFLINE     CourierStatus = 1
+	CourierStatus_COURIER_STATUS_AVAILABLE   CourierStatus = 2
+	CourierStatus_COURIER_STATUS_BUSY        CourierStatus = 3
+)
+
+// Enum value maps for CourierStatus.
+var (
+	CourierStatus_name = map[int32]string{
+		0: "COURIER_STATUS_UNSPECIFIED",
+		1: "COURIER_STATUS_OFFLINE",
+		2: "COURIER_STATUS_AVAILABLE",
+		3: "COURIER_STATUS_BUSY",
+	}
+	CourierStatus_value = map[string]int32{
+		"COURIER_STATUS_UNSPECIFIED": 0,
+		"COURIER_STATUS_OFFLINE":     1,
+		"COURIER_STATUS_AVAILABLE":   2,
+		"COURIER_STATUS_BUSY":        3,
+	}
+)
+
+func (x CourierStatus) Enum() *CourierStatus {
+	p := new(CourierStatus)
+	*p = x
+	return p
+}
+
+func (x CourierStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CourierStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_delivery_proto_enumTypes[0].Descriptor()
+}
+
+func (CourierStatus) Type() protoreflect.EnumType {
+	return &file_delivery_proto_enumTypes[0]
+}
+
+func (x CourierStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CourierStatus.Descriptor instead.
+func (CourierStatus) EnumDescriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{0}
+}
+
+type JobStatus int32
+
+const (
+	JobStatus_JOB_STATUS_UNSPECIFIED JobStatus = 0
+	JobStatus_JOB_STATUS_PENDING     JobStatus = 1
+	JobStatus_JOB_STATUS_ASSIGNED    JobStatus = 2
+	JobStatus_JOB_STATUS_PICKED_UP   JobStatus = 3
+	JobStatus_JOB_STATUS_DELIVERED   JobStatus = 4
+)
+
+// Enum value maps for JobStatus.
+var (
+	JobStatus_name = map[int32]string{
+		0: "JOB_STATUS_UNSPECIFIED",
+		1: "JOB_STATUS_PENDING",
+		2: "JOB_STATUS_ASSIGNED",
+		3: "JOB_STATUS_PICKED_UP",
+		4: "JOB_STATUS_DELIVERED",
+	}
+	JobStatus_value = map[string]int32{
+		"JOB_STATUS_UNSPECIFIED": 0,
+		"JOB_STATUS_PENDING":     1,
+		"JOB_STATUS_ASSIGNED":    2,
+		"JOB_STATUS_PICKED_UP":   3,
+		"JOB_STATUS_DELIVERED":   4,
+	}
+)
+
+func (x JobStatus) Enum() *JobStatus {
+	p := new(JobStatus)
+	*p = x
+	return p
+}
+
+func (x JobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_delivery_proto_enumTypes[1].Descriptor()
+}
+
+func (JobStatus) Type() protoreflect.EnumType {
+	return &file_delivery_proto_enumTypes[1]
+}
+
+func (x JobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobStatus.Descriptor instead.
+func (JobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{1}
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_delivery_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Location) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *Location) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+type Courier struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone             string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Status            CourierStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=delivery_v1.CourierStatus" json:"status,omitempty"`
+	Location          *Location              `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	LocationUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=location_updated_at,json=locationUpdatedAt,proto3" json:"location_updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Courier) Reset() {
+	*x = Courier{}
+	mi := &file_delivery_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Courier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Courier) ProtoMessage() {}
+
+func (x *Courier) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Courier.ProtoReflect.Descriptor instead.
+func (*Courier) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Courier) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Courier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Courier) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Courier) GetStatus() CourierStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CourierStatus_COURIER_STATUS_UNSPECIFIED
+}
+
+func (x *Courier) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Courier) GetLocationUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LocationUpdatedAt
+	}
+	return nil
+}
+
+type Shift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourierId     int64                  `protobuf:"varint,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shift) Reset() {
+	*x = Shift{}
+	mi := &file_delivery_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shift) ProtoMessage() {}
+
+func (x *Shift) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shift.ProtoReflect.Descriptor instead.
+func (*Shift) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Shift) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Shift) GetCourierId() int64 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+func (x *Shift) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Shift) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+type RegisterCourierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterCourierRequest) Reset() {
+	*x = RegisterCourierRequest{}
+	mi := &file_delivery_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterCourierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCourierRequest) ProtoMessage() {}
+
+func (x *RegisterCourierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCourierRequest.ProtoReflect.Descriptor instead.
+func (*RegisterCourierRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RegisterCourierRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterCourierRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type RegisterCourierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courier       *Courier               `protobuf:"bytes,1,opt,name=courier,proto3" json:"courier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterCourierResponse) Reset() {
+	*x = RegisterCourierResponse{}
+	mi := &file_delivery_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterCourierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCourierResponse) ProtoMessage() {}
+
+func (x *RegisterCourierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCourierResponse.ProtoReflect.Descriptor instead.
+func (*RegisterCourierResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RegisterCourierResponse) GetCourier() *Courier {
+	if x != nil {
+		return x.Courier
+	}
+	return nil
+}
+
+type GetCourierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     int64                  `protobuf:"varint,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourierRequest) Reset() {
+	*x = GetCourierRequest{}
+	mi := &file_delivery_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourierRequest) ProtoMessage() {}
+
+func (x *GetCourierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourierRequest.ProtoReflect.Descriptor instead.
+func (*GetCourierRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCourierRequest) GetCourierId() int64 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+type GetCourierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courier       *Courier               `protobuf:"bytes,1,opt,name=courier,proto3" json:"courier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourierResponse) Reset() {
+	*x = GetCourierResponse{}
+	mi := &file_delivery_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourierResponse) ProtoMessage() {}
+
+func (x *GetCourierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourierResponse.ProtoReflect.Descriptor instead.
+func (*GetCourierResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCourierResponse) GetCourier() *Courier {
+	if x != nil {
+		return x.Courier
+	}
+	return nil
+}
+
+type StartShiftRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CourierId int64                  `protobuf:"varint,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	// Where the courier starts, dispatch ignores couriers without a location.
+	Location      *Location `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartShiftRequest) Reset() {
+	*x = StartShiftRequest{}
+	mi := &file_delivery_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartShiftRequest) ProtoMessage() {}
+
+func (x *StartShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartShiftRequest.ProtoReflect.Descriptor instead.
+func (*StartShiftRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StartShiftRequest) GetCourierId() int64 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+func (x *StartShiftRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type StartShiftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shift         *Shift                 `protobuf:"bytes,1,opt,name=shift,proto3" json:"shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartShiftResponse) Reset() {
+	*x = StartShiftResponse{}
+	mi := &file_delivery_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartShiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartShiftResponse) ProtoMessage() {}
+
+func (x *StartShiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartShiftResponse.ProtoReflect.Descriptor instead.
+func (*StartShiftResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StartShiftResponse) GetShift() *Shift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+type EndShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     int64                  `protobuf:"varint,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndShiftRequest) Reset() {
+	*x = EndShiftRequest{}
+	mi := &file_delivery_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndShiftRequest) ProtoMessage() {}
+
+func (x *EndShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndShiftRequest.ProtoReflect.Descriptor instead.
+func (*EndShiftRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EndShiftRequest) GetCourierId() int64 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+type EndShiftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shift         *Shift                 `protobuf:"bytes,1,opt,name=shift,proto3" json:"shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndShiftResponse) Reset() {
+	*x = EndShiftResponse{}
+	mi := &file_delivery_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndShiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndShiftResponse) ProtoMessage() {}
+
+func (x *EndShiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndShiftResponse.ProtoReflect.Descriptor instead.
+func (*EndShiftResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EndShiftResponse) GetShift() *Shift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+type LocationUpdate struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CourierId int64                  `protobuf:"varint,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Location  *Location              `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// When the location was taken on the device, empty means now.
+	RecordedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationUpdate) Reset() {
+	*x = LocationUpdate{}
+	mi := &file_delivery_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationUpdate) ProtoMessage() {}
+
+func (x *LocationUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationUpdate.ProtoReflect.Descriptor instead.
+func (*LocationUpdate) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LocationUpdate) GetCourierId() int64 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+func (x *LocationUpdate) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *LocationUpdate) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+type UpdateLocationResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Accepted int32                  `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// Invalid or outdated updates are skipped and counted here.
+	Rejected      int32 `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocationResponse) Reset() {
+	*x = UpdateLocationResponse{}
+	mi := &file_delivery_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocationResponse) ProtoMessage() {}
+
+func (x *UpdateLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLocationResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateLocationResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *UpdateLocationResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+type DeliveryJob struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId      int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RestaurantId int64                  `protobuf:"varint,3,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Status       JobStatus              `protobuf:"varint,4,opt,name=status,proto3,enum=delivery_v1.JobStatus" json:"status,omitempty"`
+	// Zero while the job waits for a courier.
+	CourierId      int64                  `protobuf:"varint,5,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Pickup         *Location              `protobuf:"bytes,6,opt,name=pickup,proto3" json:"pickup,omitempty"`
+	Dropoff        *Location              `protobuf:"bytes,7,opt,name=dropoff,proto3" json:"dropoff,omitempty"`
+	DropoffAddress string                 `protobuf:"bytes,8,opt,name=dropoff_address,json=dropoffAddress,proto3" json:"dropoff_address,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AssignedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	PickedUpAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=picked_up_at,json=pickedUpAt,proto3" json:"picked_up_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeliveryJob) Reset() {
+	*x = DeliveryJob{}
+	mi := &file_delivery_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryJob) ProtoMessage() {}
+
+func (x *DeliveryJob) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryJob.ProtoReflect.Descriptor instead.
+func (*DeliveryJob) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeliveryJob) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeliveryJob) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *DeliveryJob) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+func (x *DeliveryJob) GetStatus() JobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobStatus_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *DeliveryJob) GetCourierId() int64 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+func (x *DeliveryJob) GetPickup() *Location {
+	if x != nil {
+		return x.Pickup
+	}
+	return nil
+}
+
+func (x *DeliveryJob) GetDropoff() *Location {
+	if x != nil {
+		return x.Dropoff
+	}
+	return nil
+}
+
+func (x *DeliveryJob) GetDropoffAddress() string {
+	if x != nil {
+		return x.DropoffAddress
+	}
+	return ""
+}
+
+func (x *DeliveryJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeliveryJob) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *DeliveryJob) GetPickedUpAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PickedUpAt
+	}
+	return nil
+}
+
+func (x *DeliveryJob) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+type GetDeliveryJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveryJobRequest) Reset() {
+	*x = GetDeliveryJobRequest{}
+	mi := &file_delivery_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryJobRequest) ProtoMessage() {}
+
+func (x *GetDeliveryJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryJobRequest.ProtoReflect.Descriptor instead.
+func (*GetDeliveryJobRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetDeliveryJobRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type GetDeliveryJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *DeliveryJob           `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveryJobResponse) Reset() {
+	*x = GetDeliveryJobResponse{}
+	mi := &file_delivery_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryJobResponse) ProtoMessage() {}
+
+func (x *GetDeliveryJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryJobResponse.ProtoReflect.Descriptor instead.
+func (*GetDeliveryJobResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetDeliveryJobResponse) GetJob() *DeliveryJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetCourierJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     int64                  `protobuf:"varint,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourierJobRequest) Reset() {
+	*x = GetCourierJobRequest{}
+	mi := &file_delivery_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourierJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourierJobRequest) ProtoMessage() {}
+
+func (x *GetCourierJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourierJobRequest.ProtoReflect.Descriptor instead.
+func (*GetCourierJobRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCourierJobRequest) GetCourierId() int64 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+type GetCourierJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *DeliveryJob           `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourierJobResponse) Reset() {
+	*x = GetCourierJobResponse{}
+	mi := &file_delivery_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourierJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourierJobResponse) ProtoMessage() {}
+
+func (x *GetCourierJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourierJobResponse.ProtoReflect.Descriptor instead.
+func (*GetCourierJobResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCourierJobResponse) GetJob() *DeliveryJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type PickUpOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         int64                  `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	CourierId     int64                  `protobuf:"varint,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickUpOrderRequest) Reset() {
+	*x = PickUpOrderRequest{}
+	mi := &file_delivery_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickUpOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickUpOrderRequest) ProtoMessage() {}
+
+func (x *PickUpOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickUpOrderRequest.ProtoReflect.Descriptor instead.
+func (*PickUpOrderRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PickUpOrderRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *PickUpOrderRequest) GetCourierId() int64 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+type PickUpOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *DeliveryJob           `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickUpOrderResponse) Reset() {
+	*x = PickUpOrderResponse{}
+	mi := &file_delivery_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickUpOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickUpOrderResponse) ProtoMessage() {}
+
+func (x *PickUpOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickUpOrderResponse.ProtoReflect.Descriptor instead.
+func (*PickUpOrderResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PickUpOrderResponse) GetJob() *DeliveryJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type DeliverOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         int64                  `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	CourierId     int64                  `protobuf:"varint,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliverOrderRequest) Reset() {
+	*x = DeliverOrderRequest{}
+	mi := &file_delivery_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliverOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverOrderRequest) ProtoMessage() {}
+
+func (x *DeliverOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverOrderRequest.ProtoReflect.Descriptor instead.
+func (*DeliverOrderRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeliverOrderRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *DeliverOrderRequest) GetCourierId() int64 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+type DeliverOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *DeliveryJob           `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliverOrderResponse) Reset() {
+	*x = DeliverOrderResponse{}
+	mi := &file_delivery_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliverOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverOrderResponse) ProtoMessage() {}
+
+func (x *DeliverOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverOrderResponse.ProtoReflect.Descriptor instead.
+func (*DeliverOrderResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeliverOrderResponse) GetJob() *DeliveryJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+var File_delivery_proto protoreflect.FileDescriptor
+
+const file_delivery_proto_rawDesc = "" +
+	"\n" +
+	"\x0edelivery.proto\x12\vdelivery_v1\x1a\x1fgoogle/protobuf/timestamp.proto\".\n" +
+	"\bLocation\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x02 \x01(\x01R\x03lng\"\xf6\x01\n" +
+	"\aCourier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x122\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1a.delivery_v1.CourierStatusR\x06status\x121\n" +
+	"\blocation\x18\x05 \x01(\v2\x15.delivery_v1.LocationR\blocation\x12J\n" +
+	"\x13location_updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x11locationUpdatedAt\"\xa8\x01\n" +
+	"\x05Shift\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\x03R\tcourierId\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\"B\n" +
+	"\x16RegisterCourierRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\"I\n" +
+	"\x17RegisterCourierResponse\x12.\n" +
+	"\acourier\x18\x01 \x01(\v2\x14.delivery_v1.CourierR\acourier\"2\n" +
+	"\x11GetCourierRequest\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\x03R\tcourierId\"D\n" +
+	"\x12GetCourierResponse\x12.\n" +
+	"\acourier\x18\x01 \x01(\v2\x14.delivery_v1.CourierR\acourier\"e\n" +
+	"\x11StartShiftRequest\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\x03R\tcourierId\x121\n" +
+	"\blocation\x18\x02 \x01(\v2\x15.delivery_v1.LocationR\blocation\">\n" +
+	"\x12StartShiftResponse\x12(\n" +
+	"\x05shift\x18\x01 \x01(\v2\x12.delivery_v1.ShiftR\x05shift\"0\n" +
+	"\x0fEndShiftRequest\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\x03R\tcourierId\"<\n" +
+	"\x10EndShiftResponse\x12(\n" +
+	"\x05shift\x18\x01 \x01(\v2\x12.delivery_v1.ShiftR\x05shift\"\x9f\x01\n" +
+	"\x0eLocationUpdate\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\x03R\tcourierId\x121\n" +
+	"\blocation\x18\x02 \x01(\v2\x15.delivery_v1.LocationR\blocation\x12;\n" +
+	"\vrecorded_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\"P\n" +
+	"\x16UpdateLocationResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted\x12\x1a\n" +
+	"\brejected\x18\x02 \x01(\x05R\brejected\"\xaa\x04\n" +
+	"\vDeliveryJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12#\n" +
+	"\rrestaurant_id\x18\x03 \x01(\x03R\frestaurantId\x12.\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.delivery_v1.JobStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x05 \x01(\x03R\tcourierId\x12-\n" +
+	"\x06pickup\x18\x06 \x01(\v2\x15.delivery_v1.LocationR\x06pickup\x12/\n" +
+	"\adropoff\x18\a \x01(\v2\x15.delivery_v1.LocationR\adropoff\x12'\n" +
+	"\x0fdropoff_address\x18\b \x01(\tR\x0edropoffAddress\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vassigned_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x12<\n" +
+	"\fpicked_up_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"pickedUpAt\x12=\n" +
+	"\fdelivered_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\"2\n" +
+	"\x15GetDeliveryJobRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\"D\n" +
+	"\x16GetDeliveryJobResponse\x12*\n" +
+	"\x03job\x18\x01 \x01(\v2\x18.delivery_v1.DeliveryJobR\x03job\"5\n" +
+	"\x14GetCourierJobRequest\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\x03R\tcourierId\"C\n" +
+	"\x15GetCourierJobResponse\x12*\n" +
+	"\x03job\x18\x01 \x01(\v2\x18.delivery_v1.DeliveryJobR\x03job\"J\n" +
+	"\x12PickUpOrderRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x03R\x05jobId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\x03R\tcourierId\"A\n" +
+	"\x13PickUpOrderResponse\x12*\n" +
+	"\x03job\x18\x01 \x01(\v2\x18.delivery_v1.DeliveryJobR\x03job\"K\n" +
+	"\x13DeliverOrderRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x03R\x05jobId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\x03R\tcourierId\"B\n" +
+	"\x14DeliverOrderResponse\x12*\n" +
+	"\x03job\x18\x01 \x01(\v2\x18.delivery_v1.DeliveryJobR\x03job*\x82\x01\n" +
+	"\rCourierStatus\x12\x1e\n" +
+	"\x1aCOURIER_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16COURIER_STATUS_OFFLINE\x10\x01\x12\x1c\n" +
+	"\x18COURIER_STATUS_AVAILABLE\x10\x02\x12\x17\n" +
+	"\x13COURIER_STATUS_BUSY\x10\x03*\x8c\x01\n" +
+	"\tJobStatus\x12\x1a\n" +
+	"\x16JOB_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12JOB_STATUS_PENDING\x10\x01\x12\x17\n" +
+	"\x13JOB_STATUS_ASSIGNED\x10\x02\x12\x18\n" +
+	"\x14JOB_STATUS_PICKED_UP\x10\x03\x12\x18\n" +
+	"\x14JOB_STATUS_DELIVERED\x10\x042\xab\x03\n" +
+	"\x0eCourierService\x12\\\n" +
+	"\x0fRegisterCourier\x12#.delivery_v1.RegisterCourierRequest\x1a$.delivery_v1.RegisterCourierResponse\x12M\n" +
+	"\n" +
+	"GetCourier\x12\x1e.delivery_v1.GetCourierRequest\x1a\x1f.delivery_v1.GetCourierResponse\x12M\n" +
+	"\n" +
+	"StartShift\x12\x1e.delivery_v1.StartShiftRequest\x1a\x1f.delivery_v1.StartShiftResponse\x12G\n" +
+	"\bEndShift\x12\x1c.delivery_v1.EndShiftRequest\x1a\x1d.delivery_v1.EndShiftResponse\x12T\n" +
+	"\x0eUpdateLocation\x12\x1b.delivery_v1.LocationUpdate\x1a#.delivery_v1.UpdateLocationResponse(\x012\xeb\x02\n" +
+	"\x0fDeliveryService\x12Y\n" +
+	"\x0eGetDeliveryJob\x12\".delivery_v1.GetDeliveryJobRequest\x1a#.delivery_v1.GetDeliveryJobResponse\x12V\n" +
+	"\rGetCourierJob\x12!.delivery_v1.GetCourierJobRequest\x1a\".delivery_v1.GetCourierJobResponse\x12P\n" +
+	"\vPickUpOrder\x12\x1f.delivery_v1.PickUpOrderRequest\x1a .delivery_v1.PickUpOrderResponse\x12S\n" +
+	"\fDeliverOrder\x12 .delivery_v1.DeliverOrderRequest\x1a!.delivery_v1.DeliverOrderResponseBRZPgithub.com/Wuchinator/food-delivery/delivery-service/pkg/delivery_v1;delivery_v1b\x06proto3"
+
+var (
+	file_delivery_proto_rawDescOnce sync.Once
+	file_delivery_proto_rawDescData []byte
+)
+
+func file_delivery_proto_rawDescGZIP() []byte {
+	file_delivery_proto_rawDescOnce.Do(func() {
+		file_delivery_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_delivery_proto_rawDesc), len(file_delivery_proto_rawDesc)))
+	})
+	return file_delivery_proto_rawDescData
+}
+
+var file_delivery_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_delivery_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_delivery_proto_goTypes = []any{
+	(CourierStatus)(0),              // 0: delivery_v1.CourierStatus
+	(JobStatus)(0),                  // 1: delivery_v1.JobStatus
+	(*Location)(nil),                // 2: delivery_v1.Location
+	(*Courier)(nil),                 // 3: delivery_v1.Courier
+	(*Shift)(nil),                   // 4: delivery_v1.Shift
+	(*RegisterCourierRequest)(nil),  // 5: delivery_v1.RegisterCourierRequest
+	(*RegisterCourierResponse)(nil), // 6: delivery_v1.RegisterCourierResponse
+	(*GetCourierRequest)(nil),       // 7: delivery_v1.GetCourierRequest
+	(*GetCourierResponse)(nil),      // 8: delivery_v1.GetCourierResponse
+	(*StartShiftRequest)(nil),       // 9: delivery_v1.StartShiftRequest
+	(*StartShiftResponse)(nil),      // 10: delivery_v1.StartShiftResponse
+	(*EndShiftRequest)(nil),         // 11: delivery_v1.EndShiftRequest
+	(*EndShiftResponse)(nil),        // 12: delivery_v1.EndShiftResponse
+	(*LocationUpdate)(nil),          // 13: delivery_v1.LocationUpdate
+	(*UpdateLocationResponse)(nil),  // 14: delivery_v1.UpdateLocationResponse
+	(*DeliveryJob)(nil),             // 15: delivery_v1.DeliveryJob
+	(*GetDeliveryJobRequest)(nil),   // 16: delivery_v1.GetDeliveryJobRequest
+	(*GetDeliveryJobResponse)(nil),  // 17: delivery_v1.GetDeliveryJobResponse
+	(*GetCourierJobRequest)(nil),    // 18: delivery_v1.GetCourierJobRequest
+	(*GetCourierJobResponse)(nil),   // 19: delivery_v1.GetCourierJobResponse
+	(*PickUpOrderRequest)(nil),      // 20: delivery_v1.PickUpOrderRequest
+	(*PickUpOrderResponse)(nil),     // 21: delivery_v1.PickUpOrderResponse
+	(*DeliverOrderRequest)(nil),     // 22: delivery_v1.DeliverOrderRequest
+	(*DeliverOrderResponse)(nil),    // 23: delivery_v1.DeliverOrderResponse
+	(*timestamppb.Timestamp)(nil),   // 24: google.protobuf.Timestamp
+}
+var file_delivery_proto_depIdxs = []int32{
+	0,  // 0: delivery_v1.Courier.status:type_name -> delivery_v1.CourierStatus
+	2,  // 1: delivery_v1.Courier.location:type_name -> delivery_v1.Location
+	24, // 2: delivery_v1.Courier.location_updated_at:type_name -> google.protobuf.Timestamp
+	24, // 3: delivery_v1.Shift.started_at:type_name -> google.protobuf.Timestamp
+	24, // 4: delivery_v1.Shift.ended_at:type_name -> google.protobuf.Timestamp
+	3,  // 5: delivery_v1.RegisterCourierResponse.courier:type_name -> delivery_v1.Courier
+	3,  // 6: delivery_v1.GetCourierResponse.courier:type_name -> delivery_v1.Courier
+	2,  // 7: delivery_v1.StartShiftRequest.location:type_name -> delivery_v1.Location
+	4,  // 8: delivery_v1.StartShiftResponse.shift:type_name -> delivery_v1.Shift
+	4,  // 9: delivery_v1.EndShiftResponse.shift:type_name -> delivery_v1.Shift
+	2,  // 10: delivery_v1.LocationUpdate.location:type_name -> delivery_v1.Location
+	24, // 11: delivery_v1.LocationUpdate.recorded_at:type_name -> google.protobuf.Timestamp
+	1,  // 12: delivery_v1.DeliveryJob.status:type_name -> delivery_v1.JobStatus
+	2,  // 13: delivery_v1.DeliveryJob.pickup:type_name -> delivery_v1.Location
+	2,  // 14: delivery_v1.DeliveryJob.dropoff:type_name -> delivery_v1.Location
+	24, // 15: delivery_v1.DeliveryJob.created_at:type_name -> google.protobuf.Timestamp
+	24, // 16: delivery_v1.DeliveryJob.assigned_at:type_name -> google.protobuf.Timestamp
+	24, // 17: delivery_v1.DeliveryJob.picked_up_at:type_name -> google.protobuf.Timestamp
+	24, // 18: delivery_v1.DeliveryJob.delivered_at:type_name -> google.protobuf.Timestamp
+	15, // 19: delivery_v1.GetDeliveryJobResponse.job:type_name -> delivery_v1.DeliveryJob
+	15, // 20: delivery_v1.GetCourierJobResponse.job:type_name -> delivery_v1.DeliveryJob
+	15, // 21: delivery_v1.PickUpOrderResponse.job:type_name -> delivery_v1.DeliveryJob
+	15, // 22: delivery_v1.DeliverOrderResponse.job:type_name -> delivery_v1.DeliveryJob
+	5,  // 23: delivery_v1.CourierService.RegisterCourier:input_type -> delivery_v1.RegisterCourierRequest
+	7,  // 24: delivery_v1.CourierService.GetCourier:input_type -> delivery_v1.GetCourierRequest
+	9,  // 25: delivery_v1.CourierService.StartShift:input_type -> delivery_v1.StartShiftRequest
+	11, // 26: delivery_v1.CourierService.EndShift:input_type -> delivery_v1.EndShiftRequest
+	13, // 27: delivery_v1.CourierService.UpdateLocation:input_type -> delivery_v1.LocationUpdate
+	16, // 28: delivery_v1.DeliveryService.GetDeliveryJob:input_type -> delivery_v1.GetDeliveryJobRequest
+	18, // 29: delivery_v1.DeliveryService.GetCourierJob:input_type -> delivery_v1.GetCourierJobRequest
+	20, // 30: delivery_v1.DeliveryService.PickUpOrder:input_type -> delivery_v1.PickUpOrderRequest
+	22, // 31: delivery_v1.DeliveryService.DeliverOrder:input_type -> delivery_v1.DeliverOrderRequest
+	6,  // 32: delivery_v1.CourierService.RegisterCourier:output_type -> delivery_v1.RegisterCourierResponse
+	8,  // 33: delivery_v1.CourierService.GetCourier:output_type -> delivery_v1.GetCourierResponse
+	10, // 34: delivery_v1.CourierService.StartShift:output_type -> delivery_v1.StartShiftResponse
+	12, // 35: delivery_v1.CourierService.EndShift:output_type -> delivery_v1.EndShiftResponse
+	14, // 36: delivery_v1.CourierService.UpdateLocation:output_type -> delivery_v1.UpdateLocationResponse
+	17, // 37: delivery_v1.DeliveryService.GetDeliveryJob:output_type -> delivery_v1.GetDeliveryJobResponse
+	19, // 38: delivery_v1.DeliveryService.GetCourierJob:output_type -> delivery_v1.GetCourierJobResponse
+	21, // 39: delivery_v1.DeliveryService.PickUpOrder:output_type -> delivery_v1.PickUpOrderResponse
+	23, // 40: delivery_v1.DeliveryService.DeliverOrder:output_type -> delivery_v1.DeliverOrderResponse
+	32, // [32:41] is the sub-list for method output_type
+	23, // [23:32] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_delivery_proto_init() }
+func file_delivery_proto_init() {
+	if File_delivery_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_delivery_proto_rawDesc), len(file_delivery_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_delivery_proto_goTypes,
+		DependencyIndexes: file_delivery_proto_depIdxs,
+		EnumInfos:         file_delivery_proto_enumTypes,
+		MessageInfos:      file_delivery_proto_msgTypes,
+	}.Build()
+	File_delivery_proto = out.File
+	file_delivery_proto_goTypes = nil
+	file_delivery_proto_depIdxs = nil
+}
@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.1
+// source: delivery.proto
+
+package delivery_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CourierService_RegisterCourier_FullMethodName = "/delivery_v1.CourierService/RegisterCourier"
+	CourierService_GetCourier_FullMethodName      = "/delivery_v1.CourierService/GetCourier"
+	CourierService_StartShift_FullMethodName      = "/delivery_v1.CourierService/StartShift"
+	CourierService_EndShift_FullMethodName        = "/delivery_v1.CourierService/EndShift"
+	CourierService_UpdateLocation_FullMethodName  = "/delivery_v1.CourierService/UpdateLocation"
+)
+
+// CourierServiceClient is the client API for CourierService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CourierServiceClient interface {
+	RegisterCourier(ctx context.Context, in *RegisterCourierRequest, opts ...grpc.CallOption) (*RegisterCourierResponse, error)
+	GetCourier(ctx context.Context, in *GetCourierRequest, opts ...grpc.CallOption) (*GetCourierResponse, error)
+	StartShift(ctx context.Context, in *StartShiftRequest, opts ...grpc.CallOption) (*StartShiftResponse, error)
+	EndShift(ctx context.Context, in *EndShiftRequest, opts ...grpc.CallOption) (*EndShiftResponse, error)
+	// UpdateLocation is streamed by the courier app while on shift.
+	UpdateLocation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[LocationUpdate, UpdateLocationResponse], error)
+}
+
+type courierServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCourierServiceClient(cc grpc.ClientConnInterface) CourierServiceClient {
+	return &courierServiceClient{cc}
+}
+
+func (c *courierServiceClient) RegisterCourier(ctx context.Context, in *RegisterCourierRequest, opts ...grpc.CallOption) (*RegisterCourierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterCourierResponse)
+	err := c.cc.Invoke(ctx, CourierService_RegisterCourier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierServiceClient) GetCourier(ctx context.Context, in *GetCourierRequest, opts ...grpc.CallOption) (*GetCourierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourierResponse)
+	err := c.cc.Invoke(ctx, CourierService_GetCourier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierServiceClient) StartShift(ctx context.Context, in *StartShiftRequest, opts ...grpc.CallOption) (*StartShiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartShiftResponse)
+	err := c.cc.Invoke(ctx, CourierService_StartShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierServiceClient) EndShift(ctx context.Context, in *EndShiftRequest, opts ...grpc.CallOption) (*EndShiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndShiftResponse)
+	err := c.cc.Invoke(ctx, CourierService_EndShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierServiceClient) UpdateLocation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[LocationUpdate, UpdateLocationResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CourierService_ServiceDesc.Streams[0], CourierService_UpdateLocation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LocationUpdate, UpdateLocationResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourierService_UpdateLocationClient = grpc.ClientStreamingClient[LocationUpdate, UpdateLocationResponse]
+
+// CourierServiceServer is the server API for CourierService service.
+// All implementations must embed UnimplementedCourierServiceServer
+// for forward compatibility.
+type CourierServiceServer interface {
+	RegisterCourier(context.Context, *RegisterCourierRequest) (*RegisterCourierResponse, error)
+	GetCourier(context.Context, *GetCourierRequest) (*GetCourierResponse, error)
+	StartShift(context.Context, *StartShiftRequest) (*StartShiftResponse, error)
+	EndShift(context.Context, *EndShiftRequest) (*EndShiftResponse, error)
+	// UpdateLocation is streamed by the courier app while on shift.
+	UpdateLocation(grpc.ClientStreamingServer[LocationUpdate, UpdateLocationResponse]) error
+	mustEmbedUnimplementedCourierServiceServer()
+}
+
+// UnimplementedCourierServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCourierServiceServer struct{}
+
+func (UnimplementedCourierServiceServer) RegisterCourier(context.Context, *RegisterCourierRequest) (*RegisterCourierResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterCourier not implemented")
+}
+func (UnimplementedCourierServiceServer) GetCourier(context.Context, *GetCourierRequest) (*GetCourierResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCourier not implemented")
+}
+func (UnimplementedCourierServiceServer) StartShift(context.Context, *StartShiftRequest) (*StartShiftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartShift not implemented")
+}
+func (UnimplementedCourierServiceServer) EndShift(context.Context, *EndShiftRequest) (*EndShiftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EndShift not implemented")
+}
+func (UnimplementedCourierServiceServer) UpdateLocation(grpc.ClientStreamingServer[LocationUpdate, UpdateLocationResponse]) error {
+	return status.Error(codes.Unimplemented, "method UpdateLocation not implemented")
+}
+func (UnimplementedCourierServiceServer) mustEmbedUnimplementedCourierServiceServer() {}
+func (UnimplementedCourierServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCourierServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CourierServiceServer will
+// result in compilation errors.
+type UnsafeCourierServiceServer interface {
+	mustEmbedUnimplementedCourierServiceServer()
+}
+
+func RegisterCourierServiceServer(s grpc.ServiceRegistrar, srv CourierServiceServer) {
+	// If the following call panics, it indicates UnimplementedCourierServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CourierService_ServiceDesc, srv)
+}
+
+func _CourierService_RegisterCourier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCourierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).RegisterCourier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_RegisterCourier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).RegisterCourier(ctx, req.(*RegisterCourierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierService_GetCourier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).GetCourier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_GetCourier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).GetCourier(ctx, req.(*GetCourierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierService_StartShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).StartShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_StartShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).StartShift(ctx, req.(*StartShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierService_EndShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).EndShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_EndShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).EndShift(ctx, req.(*EndShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierService_UpdateLocation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CourierServiceServer).UpdateLocation(&grpc.GenericServerStream[LocationUpdate, UpdateLocationResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourierService_UpdateLocationServer = grpc.ClientStreamingServer[LocationUpdate, UpdateLocationResponse]
+
+// CourierService_ServiceDesc is the grpc.ServiceDesc for CourierService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CourierService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "delivery_v1.CourierService",
+	HandlerType: (*CourierServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterCourier",
+			Handler:    _CourierService_RegisterCourier_Handler,
+		},
+		{
+			MethodName: "GetCourier",
+			Handler:    _CourierService_GetCourier_Handler,
+		},
+		{
+			MethodName: "StartShift",
+			Handler:    _CourierService_StartShift_Handler,
+		},
+		{
+			MethodName: "EndShift",
+			Handler:    _CourierService_EndShift_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UpdateLocation",
+			Handler:       _CourierService_UpdateLocation_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "delivery.proto",
+}
+
+const (
+	DeliveryService_GetDeliveryJob_FullMethodName = "/delivery_v1.DeliveryService/GetDeliveryJob"
+	DeliveryService_GetCourierJob_FullMethodName  = "/delivery_v1.DeliveryService/GetCourierJob"
+	DeliveryService_PickUpOrder_FullMethodName    = "/delivery_v1.DeliveryService/PickUpOrder"
+	DeliveryService_DeliverOrder_FullMethodName   = "/delivery_v1.DeliveryService/DeliverOrder"
+)
+
+// DeliveryServiceClient is the client API for DeliveryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeliveryServiceClient interface {
+	GetDeliveryJob(ctx context.Context, in *GetDeliveryJobRequest, opts ...grpc.CallOption) (*GetDeliveryJobResponse, error)
+	GetCourierJob(ctx context.Context, in *GetCourierJobRequest, opts ...grpc.CallOption) (*GetCourierJobResponse, error)
+	PickUpOrder(ctx context.Context, in *PickUpOrderRequest, opts ...grpc.CallOption) (*PickUpOrderResponse, error)
+	DeliverOrder(ctx context.Context, in *DeliverOrderRequest, opts ...grpc.CallOption) (*DeliverOrderResponse, error)
+}
+
+type deliveryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeliveryServiceClient(cc grpc.ClientConnInterface) DeliveryServiceClient {
+	return &deliveryServiceClient{cc}
+}
+
+func (c *deliveryServiceClient) GetDeliveryJob(ctx context.Context, in *GetDeliveryJobRequest, opts ...grpc.CallOption) (*GetDeliveryJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeliveryJobResponse)
+	err := c.cc.Invoke(ctx, DeliveryService_GetDeliveryJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryServiceClient) GetCourierJob(ctx context.Context, in *GetCourierJobRequest, opts ...grpc.CallOption) (*GetCourierJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourierJobResponse)
+	err := c.cc.Invoke(ctx, DeliveryService_GetCourierJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryServiceClient) PickUpOrder(ctx context.Context, in *PickUpOrderRequest, opts ...grpc.CallOption) (*PickUpOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickUpOrderResponse)
+	err := c.cc.Invoke(ctx, DeliveryService_PickUpOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryServiceClient) DeliverOrder(ctx context.Context, in *DeliverOrderRequest, opts ...grpc.CallOption) (*DeliverOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeliverOrderResponse)
+	err := c.cc.Invoke(ctx, DeliveryService_DeliverOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeliveryServiceServer is the server API for DeliveryService service.
+// All implementations must embed UnimplementedDeliveryServiceServer
+// for forward compatibility.
+type DeliveryServiceServer interface {
+	GetDeliveryJob(context.Context, *GetDeliveryJobRequest) (*GetDeliveryJobResponse, error)
+	GetCourierJob(context.Context, *GetCourierJobRequest) (*GetCourierJobResponse, error)
+	PickUpOrder(context.Context, *PickUpOrderRequest) (*PickUpOrderResponse, error)
+	DeliverOrder(context.Context, *DeliverOrderRequest) (*DeliverOrderResponse, error)
+	mustEmbedUnimplementedDeliveryServiceServer()
+}
+
+// UnimplementedDeliveryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDeliveryServiceServer struct{}
+
+func (UnimplementedDeliveryServiceServer) GetDeliveryJob(context.Context, *GetDeliveryJobRequest) (*GetDeliveryJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDeliveryJob not implemented")
+}
+func (UnimplementedDeliveryServiceServer) GetCourierJob(context.Context, *GetCourierJobRequest) (*GetCourierJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCourierJob not implemented")
+}
+func (UnimplementedDeliveryServiceServer) PickUpOrder(context.Context, *PickUpOrderRequest) (*PickUpOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PickUpOrder not implemented")
+}
+func (UnimplementedDeliveryServiceServer) DeliverOrder(context.Context, *DeliverOrderRequest) (*DeliverOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeliverOrder not implemented")
+}
+func (UnimplementedDeliveryServiceServer) mustEmbedUnimplementedDeliveryServiceServer() {}
+func (UnimplementedDeliveryServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeDeliveryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeliveryServiceServer will
+// result in compilation errors.
+type UnsafeDeliveryServiceServer interface {
+	mustEmbedUnimplementedDeliveryServiceServer()
+}
+
+func RegisterDeliveryServiceServer(s grpc.ServiceRegistrar, srv DeliveryServiceServer) {
+	// If the following call panics, it indicates UnimplementedDeliveryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DeliveryService_ServiceDesc, srv)
+}
+
+func _DeliveryService_GetDeliveryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeliveryJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServiceServer).GetDeliveryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryService_GetDeliveryJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServiceServer).GetDeliveryJob(ctx, req.(*GetDeliveryJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryService_GetCourierJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourierJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServiceServer).GetCourierJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryService_GetCourierJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServiceServer).GetCourierJob(ctx, req.(*GetCourierJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryService_PickUpOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickUpOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServiceServer).PickUpOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryService_PickUpOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServiceServer).PickUpOrder(ctx, req.(*PickUpOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryService_DeliverOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliverOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServiceServer).DeliverOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryService_DeliverOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServiceServer).DeliverOrder(ctx, req.(*DeliverOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeliveryService_ServiceDesc is the grpc.ServiceDesc for DeliveryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeliveryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "delivery_v1.DeliveryService",
+	HandlerType: (*DeliveryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDeliveryJob",
+			Handler:    _DeliveryService_GetDeliveryJob_Handler,
+		},
+		{
+			MethodName: "GetCourierJob",
+			Handler:    _DeliveryService_GetCourierJob_Handler,
+		},
+		{
+			MethodName: "PickUpOrder",
+			Handler:    _DeliveryService_PickUpOrder_Handler,
+		},
+		{
+			MethodName: "DeliverOrder",
+			Handler:    _DeliveryService_DeliverOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "delivery.proto",
+}
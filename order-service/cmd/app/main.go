@@ -5,17 +5,28 @@ import (
 	"time"
 
 	"github.com/Wuchinator/food-delivery/order-service/internal/adapter/db/postgres"
+	"github.com/Wuchinator/food-delivery/order-service/internal/adapter/geocoder"
 	"github.com/Wuchinator/food-delivery/order-service/internal/adapter/kafka"
+	"github.com/Wuchinator/food-delivery/order-service/internal/adapter/payment"
+	"github.com/Wuchinator/food-delivery/order-service/internal/adapter/restaurant"
 	"github.com/Wuchinator/food-delivery/order-service/internal/app"
 	"github.com/Wuchinator/food-delivery/order-service/internal/app/database"
 	"github.com/Wuchinator/food-delivery/order-service/internal/app/logger"
 	"github.com/Wuchinator/food-delivery/order-service/internal/config"
 	orderGrpc "github.com/Wuchinator/food-delivery/order-service/internal/handler/grpc"
+	kafkaHandler "github.com/Wuchinator/food-delivery/order-service/internal/handler/kafka"
 	"github.com/Wuchinator/food-delivery/order-service/internal/usecase"
+	"github.com/Wuchinator/food-delivery/order-service/internal/worker"
 	pb "github.com/Wuchinator/food-delivery/order-service/pkg/order_v1"
+	"github.com/Wuchinator/food-delivery/pkg/consumer"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/Wuchinator/food-delivery/pkg/outbox"
+	"github.com/Wuchinator/food-delivery/pkg/outbox/pgstore"
+	restaurantpb "github.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 )
@@ -34,14 +45,20 @@ func main() {
 	log = logger.WithService(log, "order-service")
 	log.Info("Starting order service", zap.String("environment", cfg.Environment), zap.String("grpc port", cfg.GRPCPort))
 
-	kafka := kafka.NewProducer(kafka.Config{
+	producer := kafka.NewProducer(kafka.Config{
 		Brokers:         cfg.Kafka.Brokers,
 		Topic:           cfg.Kafka.Topic,
+		CancelledTopic:  cfg.Kafka.CancelledTopic,
+		PaymentsTopic:   cfg.Kafka.PaymentsTopic,
 		ProducerTimeout: cfg.Kafka.ProducerTimeout,
 		RequireAcks:     cfg.Kafka.RequireAcks,
+
+		RestaurantCommandsTopic: cfg.Kafka.RestaurantCommandsTopic,
+		PaymentCommandsTopic:    cfg.Kafka.PaymentCommandsTopic,
+		SagaRepliesTopic:        cfg.Kafka.SagaRepliesTopic,
 	}, log)
 
-	defer kafka.Close()
+	defer producer.Close()
 
 	db, err := database.NewConnection(database.Config{
 		DSN:             cfg.Postgres.PostgresDSN(),
@@ -56,9 +73,31 @@ func main() {
 
 	defer db.Close()
 
+	restaurantConn, err := grpc.NewClient(cfg.Restaurant.Addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("Failed to create restaurant service client", zap.Error(err))
+	}
+
+	defer restaurantConn.Close()
+
+	restaurantClient := restaurantpb.NewRestaurantServiceClient(restaurantConn)
+	pricer := restaurant.NewMenuPricer(restaurantClient, cfg.Restaurant.Timeout, log)
+	deliveryChecker := restaurant.NewDeliveryChecker(restaurantClient, cfg.Restaurant.Timeout, log)
+	availability := restaurant.NewAvailabilityChecker(restaurantClient, cfg.Restaurant.Timeout, log)
+	prepTimer := restaurant.NewPrepTimer(restaurantClient, cfg.Restaurant.Timeout, log)
+	encoder := events.NewEncoder(cfg.Kafka.EventFormat)
+
 	grpcServer := grpc.NewServer(
-		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
-		grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(
+			grpc_prometheus.StreamServerInterceptor,
+			orderGrpc.ErrorStreamInterceptor(log),
+		),
+		grpc.ChainUnaryInterceptor(
+			grpc_prometheus.UnaryServerInterceptor,
+			orderGrpc.TraceUnaryInterceptor,
+			orderGrpc.ErrorUnaryInterceptor(log),
+		),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			MaxConnectionIdle: 5 * time.Minute,
 			Timeout:           20 * time.Second,
@@ -68,11 +107,122 @@ func main() {
 	grpc_prometheus.Register(grpcServer)
 
 	orderRepo := postgres.NewOrderRepository(db.Pool, log)
-	uc := usecase.NewCreateOrderUseCase(orderRepo, log, kafka)
-	orderHandler := orderGrpc.NewServer(uc, log)
+	paymentRepo := postgres.NewPaymentRepository(db.Pool, log)
+	gateway := payment.NewFakeGateway(cfg.Payment.FakeDeclineOver, log)
+	cancelOrder := usecase.NewCancelOrderUseCase(orderRepo, log, encoder)
+	// Status changes of all instances wake up WatchOrder streams through the feed.
+	statusFeed := usecase.NewStatusFeed()
+	statusListener := postgres.NewStatusListener(db.Pool, statusFeed, cfg.Watch.ListenRetryDelay, log)
+	orderHandler := orderGrpc.NewServer(orderGrpc.UseCases{
+		CreateOrder: usecase.NewCreateOrderUseCase(orderRepo, log, pricer,
+			geocoder.NewOfflineGeocoder(), deliveryChecker, availability, prepTimer, encoder),
+		CancelOrder: cancelOrder,
+		GetOrder:    usecase.NewGetOrderUseCase(orderRepo, log),
+		ListOrders:  usecase.NewListOrdersUseCase(orderRepo, log),
+		History:     usecase.NewGetOrderHistoryUseCase(orderRepo, log),
+		RefundOrder: usecase.NewRefundOrderUseCase(orderRepo, paymentRepo,
+			postgres.NewRefundRepository(db.Pool, log), gateway, log, encoder),
+		WatchOrder: usecase.NewWatchOrderUseCase(orderRepo, statusFeed, prepTimer,
+			usecase.WatchOrderConfig{PollInterval: cfg.Watch.PollInterval}, log),
+	}, log)
 	pb.RegisterOrderServiceServer(grpcServer, orderHandler)
 	reflection.Register(grpcServer)
 
-	App := app.NewApp(cfg, log, grpcServer)
+	outboxRepo := pgstore.New(db.Pool, log)
+	outboxRelay := outbox.NewRelay(
+		outboxRepo,
+		producer,
+		outbox.RelayConfig{
+			PollInterval:     cfg.Outbox.PollInterval,
+			BatchSize:        cfg.Outbox.BatchSize,
+			MetricsNamespace: "order",
+		}, log)
+
+	scheduler := worker.NewScheduler(
+		usecase.NewReleaseScheduledUseCase(orderRepo, log, encoder),
+		worker.SchedulerConfig{
+			PollInterval: cfg.Scheduler.PollInterval,
+			BatchSize:    cfg.Scheduler.BatchSize,
+		}, log)
+
+	// Failed messages are retried, then parked in a dead-letter topic, and never
+	// committed unhandled.
+	consumerConfig := func(topic, groupID string) consumer.Config {
+		return consumer.Config{
+			Brokers:  cfg.Kafka.Brokers,
+			Topic:    topic,
+			GroupID:  groupID,
+			TimeOut:  cfg.Kafka.ConsumerTimeout,
+			DLQTopic: cfg.Kafka.DLQTopic(groupID, topic),
+			Retry: consumer.RetryPolicy{
+				MaxAttempts:    cfg.Kafka.MaxAttempts,
+				InitialBackoff: cfg.Kafka.InitialBackoff,
+				MaxBackoff:     cfg.Kafka.MaxBackoff,
+			},
+			Pool: consumer.PoolConfig{
+				Workers:        cfg.Kafka.Workers,
+				QueueSize:      cfg.Kafka.QueueSize,
+				CommitInterval: cfg.Kafka.CommitInterval,
+				DrainTimeout:   cfg.Kafka.DrainTimeout,
+			},
+			MetricsNamespace: "order_consumer",
+		}
+	}
+
+	changeStatus := usecase.NewChangeStatusUseCase(orderRepo, log)
+	kitchenConsumer := consumer.NewConsumer(consumerConfig(cfg.Kafka.KitchenStatusTopic, cfg.Kafka.GroupID),
+		kafkaHandler.NewKitchenStatusHandler(changeStatus, log), log)
+
+	defer kitchenConsumer.Close()
+
+	deliveryConsumer := consumer.NewConsumer(consumerConfig(cfg.Kafka.DeliveryStatusTopic, cfg.Kafka.GroupID),
+		kafkaHandler.NewDeliveryStatusHandler(changeStatus, log), log)
+
+	defer deliveryConsumer.Close()
+
+	// The saga starts on OrderCreated in its own group and is driven by
+	// participant replies and its timeouts.
+	orderSaga := usecase.NewOrderSagaUseCase(postgres.NewSagaRepository(db.Pool, log), orderRepo,
+		encoder, usecase.OrderSagaConfig{
+			StepTimeout:             cfg.Saga.StepTimeout,
+			MaxCompensationAttempts: cfg.Saga.MaxCompensationAttempts,
+		}, log)
+	sagaHandler := kafkaHandler.NewSagaHandler(orderSaga, log)
+
+	sagaOrderConsumer := consumer.NewConsumer(consumerConfig(cfg.Kafka.Topic, cfg.Kafka.SagaGroupID),
+		sagaHandler, log)
+
+	defer sagaOrderConsumer.Close()
+
+	sagaReplyConsumer := consumer.NewConsumer(consumerConfig(cfg.Kafka.SagaRepliesTopic, cfg.Kafka.SagaGroupID),
+		sagaHandler, log)
+
+	defer sagaReplyConsumer.Close()
+
+	sagaTimeouts := worker.NewSagaTimeouts(orderSaga, worker.SagaTimeoutsConfig{
+		PollInterval: cfg.Saga.PollInterval,
+		BatchSize:    cfg.Saga.BatchSize,
+	}, log)
+
+	// Payments run the authorize_payment saga step from payment commands and
+	// capture on kitchen events, both in their own group.
+	paymentHandler := kafkaHandler.NewPaymentHandler(
+		usecase.NewAuthorizePaymentUseCase(orderRepo, paymentRepo, gateway, log, encoder),
+		usecase.NewVoidPaymentUseCase(paymentRepo, outboxRepo, gateway, log, encoder),
+		usecase.NewCapturePaymentUseCase(paymentRepo, gateway, log),
+		log)
+
+	paymentCommandConsumer := consumer.NewConsumer(consumerConfig(cfg.Kafka.PaymentCommandsTopic, cfg.Kafka.PaymentsGroupID),
+		paymentHandler, log)
+
+	defer paymentCommandConsumer.Close()
+
+	paymentKitchenConsumer := consumer.NewConsumer(consumerConfig(cfg.Kafka.KitchenStatusTopic, cfg.Kafka.PaymentsGroupID),
+		paymentHandler, log)
+
+	defer paymentKitchenConsumer.Close()
+
+	App := app.NewApp(cfg, log, grpcServer, outboxRelay, scheduler, sagaTimeouts, statusListener, kitchenConsumer, deliveryConsumer,
+		sagaOrderConsumer, sagaReplyConsumer, paymentCommandConsumer, paymentKitchenConsumer)
 	App.Run()
 }
@@ -4,13 +4,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/outbox/pgstore"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
 
+const uniqueViolationCode = "23505"
+
+// idempotencyKeyIndex is the unique index of order idempotency keys per user.
+const idempotencyKeyIndex = "uq_orders_user_id_idempotency_key"
+
 type OrderRepository struct {
 	pool   *pgxpool.Pool
 	logger *zap.Logger
@@ -23,7 +34,7 @@ func NewOrderRepository(pool *pgxpool.Pool, logger *zap.Logger) *OrderRepository
 	}
 }
 
-func (r *OrderRepository) Create(ctx context.Context, order *domain.Order) (int64, error) {
+func (r *OrderRepository) Create(ctx context.Context, order *domain.Order, event domain.EventFactory) (int64, error) {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to start transaction: %w", err)
@@ -31,18 +42,29 @@ func (r *OrderRepository) Create(ctx context.Context, order *domain.Order) (int6
 	defer tx.Rollback(ctx)
 
 	queryOrder := `
-		INSERT INTO orders (user_id, restaurant_id, status, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO orders (user_id, restaurant_id, total, status, created_at, updated_at,
+			idempotency_key, request_hash, delivery_address,
+			delivery_street, delivery_apartment, delivery_city, delivery_lat, delivery_lng, delivery_comment,
+			scheduled_for, release_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), NULLIF($8, ''), $9, $10, $11, $12, $13, $14, $15, $16, $17)
 		RETURNING id
 	`
 
 	var orderID int64
 	err = tx.QueryRow(ctx,
 		queryOrder,
-		order.UserID, order.RestaurantID, order.Status, order.CreatedAt, order.UpdatedAt,
+		order.UserID, order.RestaurantID, order.Total, order.Status, order.CreatedAt, order.UpdatedAt,
+		order.IdempotencyKey, order.RequestHash, order.DeliveryAddress.String(),
+		order.DeliveryAddress.Street, order.DeliveryAddress.Apartment, order.DeliveryAddress.City,
+		order.DeliveryAddress.Lat, order.DeliveryAddress.Lng, order.DeliveryAddress.Comment,
+		timestamptz(order.ScheduledFor), timestamptz(order.ReleaseAt),
 	).Scan(&orderID)
 
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == idempotencyKeyIndex {
+			return 0, domain.ErrIdempotencyConflict
+		}
 		r.logger.Error("failed to insert order", zap.Error(err))
 		return 0, fmt.Errorf("failed to insert order: %w", err)
 	}
@@ -72,6 +94,30 @@ func (r *OrderRepository) Create(ctx context.Context, order *domain.Order) (int6
 		return 0, fmt.Errorf("failed to close batch results: %w", err)
 	}
 
+	err = insertStatusHistory(ctx, tx, domain.StatusChange{
+		OrderID:   orderID,
+		To:        order.Status,
+		Actor:     domain.ActorCustomer,
+		ChangedAt: order.CreatedAt,
+	})
+	if err != nil {
+		r.logger.Error("failed to insert status history", zap.Error(err))
+		return 0, err
+	}
+
+	if event != nil {
+		order.ID = orderID
+		msg, err := event(order)
+		if err != nil {
+			return 0, fmt.Errorf("failed to build outbox message: %w", err)
+		}
+
+		if err := pgstore.Insert(ctx, tx, msg); err != nil {
+			r.logger.Error("failed to insert outbox message", zap.Error(err))
+			return 0, err
+		}
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		r.logger.Error("failed to commit transaction", zap.Error(err))
 		return 0, fmt.Errorf("failed to commit transaction: %w", err)
@@ -90,7 +136,9 @@ func (r *OrderRepository) GetByID(ctx context.Context, id int64) (*domain.Order,
 	defer tx.Rollback(ctx)
 
 	queryOrder := `
-		SELECT id, user_id, restaurant_id, status, created_at, updated_at
+		SELECT id, user_id, restaurant_id, total, status, created_at, updated_at,
+			delivery_street, delivery_apartment, delivery_city, delivery_lat, delivery_lng, delivery_comment,
+			scheduled_for, release_at
 		FROM orders
 		WHERE id = $1
 	`
@@ -99,13 +147,22 @@ func (r *OrderRepository) GetByID(ctx context.Context, id int64) (*domain.Order,
 		&order.ID,
 		&order.UserID,
 		&order.RestaurantID,
+		&order.Total,
 		&order.Status,
 		&order.CreatedAt,
 		&order.UpdatedAt,
+		&order.DeliveryAddress.Street,
+		&order.DeliveryAddress.Apartment,
+		&order.DeliveryAddress.City,
+		&order.DeliveryAddress.Lat,
+		&order.DeliveryAddress.Lng,
+		&order.DeliveryAddress.Comment,
+		(*nullTime)(&order.ScheduledFor),
+		(*nullTime)(&order.ReleaseAt),
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("order with id %d not found", id)
+			return nil, fmt.Errorf("order with id %d: %w", id, domain.ErrOrderNotFound)
 		}
 		r.logger.Error("failed to get order by id", zap.Int64("order_id", id), zap.Error(err))
 		return nil, fmt.Errorf("get order by id: %w", err)
@@ -143,3 +200,349 @@ func (r *OrderRepository) GetByID(ctx context.Context, id int64) (*domain.Order,
 
 	return &order, nil
 }
+
+func (r *OrderRepository) GetByIdempotencyKey(ctx context.Context, userID int64, key string) (*domain.Order, error) {
+	query := `
+		SELECT id, user_id, restaurant_id, total, status, created_at, updated_at,
+			delivery_street, delivery_apartment, delivery_city, delivery_lat, delivery_lng, delivery_comment,
+			scheduled_for, release_at, idempotency_key, COALESCE(request_hash, '')
+		FROM orders
+		WHERE user_id = $1 AND idempotency_key = $2
+	`
+
+	var order domain.Order
+	err := r.pool.QueryRow(ctx, query, userID, key).Scan(
+		&order.ID,
+		&order.UserID,
+		&order.RestaurantID,
+		&order.Total,
+		&order.Status,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+		&order.DeliveryAddress.Street,
+		&order.DeliveryAddress.Apartment,
+		&order.DeliveryAddress.City,
+		&order.DeliveryAddress.Lat,
+		&order.DeliveryAddress.Lng,
+		&order.DeliveryAddress.Comment,
+		(*nullTime)(&order.ScheduledFor),
+		(*nullTime)(&order.ReleaseAt),
+		&order.IdempotencyKey,
+		&order.RequestHash,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("order with idempotency key %q: %w", key, domain.ErrOrderNotFound)
+		}
+		r.logger.Error("failed to get order by idempotency key", zap.Int64("user_id", userID), zap.Error(err))
+		return nil, fmt.Errorf("get order by idempotency key: %w", err)
+	}
+
+	return &order, nil
+}
+
+func (r *OrderRepository) List(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, error) {
+	conditions := make([]string, 0, 6)
+	args := make([]any, 0, 7)
+
+	addCondition := func(cond string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.UserID != 0 {
+		addCondition("user_id = $%d", filter.UserID)
+	}
+	if filter.RestaurantID != 0 {
+		addCondition("restaurant_id = $%d", filter.RestaurantID)
+	}
+	if filter.Status != "" {
+		addCondition("status = $%d", filter.Status)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		addCondition("created_at >= $%d", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		addCondition("created_at < $%d", filter.CreatedTo)
+	}
+	if filter.AfterID != 0 {
+		addCondition("id < $%d", filter.AfterID)
+	}
+
+	query := `
+		SELECT id, user_id, restaurant_id, total, status, created_at, updated_at,
+			delivery_street, delivery_apartment, delivery_city, delivery_lat, delivery_lng, delivery_comment,
+			scheduled_for, release_at
+		FROM orders
+	`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(" ORDER BY id DESC LIMIT $%d", len(args))
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("failed to list orders", zap.Error(err))
+		return nil, fmt.Errorf("list orders: %w", err)
+	}
+	defer rows.Close()
+
+	orders := make([]domain.Order, 0, filter.Limit)
+	index := make(map[int64]int, filter.Limit)
+	ids := make([]int64, 0, filter.Limit)
+	for rows.Next() {
+		var order domain.Order
+		if err := rows.Scan(
+			&order.ID,
+			&order.UserID,
+			&order.RestaurantID,
+			&order.Total,
+			&order.Status,
+			&order.CreatedAt,
+			&order.UpdatedAt,
+			&order.DeliveryAddress.Street,
+			&order.DeliveryAddress.Apartment,
+			&order.DeliveryAddress.City,
+			&order.DeliveryAddress.Lat,
+			&order.DeliveryAddress.Lng,
+			&order.DeliveryAddress.Comment,
+			(*nullTime)(&order.ScheduledFor),
+			(*nullTime)(&order.ReleaseAt),
+		); err != nil {
+			r.logger.Error("failed to scan order", zap.Error(err))
+			return nil, fmt.Errorf("scan order: %w", err)
+		}
+		index[order.ID] = len(orders)
+		ids = append(ids, order.ID)
+		orders = append(orders, order)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error during iterating orders", zap.Error(err))
+		return nil, fmt.Errorf("iterating orders: %w", err)
+	}
+
+	if len(orders) == 0 {
+		return orders, nil
+	}
+
+	queryItems := `
+		SELECT order_id, product_id, quantity, price
+		FROM orders_items
+		WHERE order_id = ANY($1)
+		ORDER BY id
+	`
+	itemRows, err := r.pool.Query(ctx, queryItems, ids)
+	if err != nil {
+		r.logger.Error("failed to list order items", zap.Error(err))
+		return nil, fmt.Errorf("list order items: %w", err)
+	}
+	defer itemRows.Close()
+
+	for itemRows.Next() {
+		var (
+			orderID int64
+			item    domain.OrderItem
+		)
+		if err := itemRows.Scan(&orderID, &item.ProductID, &item.Quantity, &item.Price); err != nil {
+			r.logger.Error("failed to scan order item", zap.Error(err))
+			return nil, fmt.Errorf("scan order item: %w", err)
+		}
+		i := index[orderID]
+		orders[i].Items = append(orders[i].Items, item)
+	}
+
+	if err := itemRows.Err(); err != nil {
+		r.logger.Error("error during iterating order items", zap.Error(err))
+		return nil, fmt.Errorf("iterating order items: %w", err)
+	}
+
+	return orders, nil
+}
+
+func (r *OrderRepository) UpdateStatus(ctx context.Context, change domain.StatusChange,
+	events ...*domain.OutboxMessage) error {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := updateOrderStatus(ctx, tx, r.logger, change); err != nil {
+		return err
+	}
+
+	for _, msg := range events {
+		if err := pgstore.Insert(ctx, tx, msg); err != nil {
+			r.logger.Error("failed to insert outbox message", zap.Int64("order_id", change.OrderID), zap.Error(err))
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.logger.Info("order status updated",
+		zap.Int64("order_id", change.OrderID),
+		zap.String("status", string(change.To)),
+		zap.String("actor", change.Actor))
+
+	return nil
+}
+
+func (r *OrderRepository) DueScheduled(ctx context.Context, t time.Time, limit int) ([]int64, error) {
+	query := `
+		SELECT id
+		FROM orders
+		WHERE status = $1 AND release_at <= $2
+		ORDER BY release_at
+		LIMIT $3
+	`
+
+	rows, err := r.pool.Query(ctx, query, domain.OrderScheduled, t, limit)
+	if err != nil {
+		r.logger.Error("failed to select due scheduled orders", zap.Error(err))
+		return nil, fmt.Errorf("select due scheduled orders: %w", err)
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		r.logger.Error("failed to scan due scheduled orders", zap.Error(err))
+		return nil, fmt.Errorf("scan due scheduled orders: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *OrderRepository) Release(ctx context.Context, order *domain.Order,
+	change domain.StatusChange, event domain.EventFactory) error {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := updateOrderStatus(ctx, tx, r.logger, change); err != nil {
+		return err
+	}
+
+	msg, err := event(order)
+	if err != nil {
+		return fmt.Errorf("failed to build outbox message: %w", err)
+	}
+
+	if err := pgstore.Insert(ctx, tx, msg); err != nil {
+		r.logger.Error("failed to insert outbox message", zap.Int64("order_id", order.ID), zap.Error(err))
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.logger.Info("scheduled order released", zap.Int64("order_id", order.ID))
+
+	return nil
+}
+
+// updateOrderStatus moves the order from change.From to change.To and records the change in history.
+func updateOrderStatus(ctx context.Context, tx pgx.Tx, logger *zap.Logger, change domain.StatusChange) error {
+	query := `
+		UPDATE orders
+		SET status = $3, updated_at = $4
+		WHERE id = $1 AND status = $2
+	`
+
+	tag, err := tx.Exec(ctx, query, change.OrderID, change.From, change.To, change.ChangedAt)
+	if err != nil {
+		logger.Error("failed to update order status", zap.Int64("order_id", change.OrderID), zap.Error(err))
+		return fmt.Errorf("update order status: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		logger.Warn("order status was not updated",
+			zap.Int64("order_id", change.OrderID),
+			zap.String("from", string(change.From)),
+			zap.String("to", string(change.To)))
+		return domain.ErrOrderStatusConflict
+	}
+
+	if err := insertStatusHistory(ctx, tx, change); err != nil {
+		logger.Error("failed to insert status history", zap.Int64("order_id", change.OrderID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) History(ctx context.Context, orderID int64) ([]domain.StatusChange, error) {
+	query := `
+		SELECT order_id, COALESCE(from_status, ''), to_status, actor, reason, created_at
+		FROM order_status_history
+		WHERE order_id = $1
+		ORDER BY id
+	`
+
+	rows, err := r.pool.Query(ctx, query, orderID)
+	if err != nil {
+		r.logger.Error("failed to get order status history", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("get order status history: %w", err)
+	}
+
+	history, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.StatusChange, error) {
+		var change domain.StatusChange
+		err := row.Scan(&change.OrderID, &change.From, &change.To,
+			&change.Actor, &change.Reason, &change.ChangedAt)
+		return change, err
+	})
+	if err != nil {
+		r.logger.Error("failed to scan order status history", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("scan order status history: %w", err)
+	}
+
+	return history, nil
+}
+
+func insertStatusHistory(ctx context.Context, tx pgx.Tx, change domain.StatusChange) error {
+	query := `
+		INSERT INTO order_status_history (order_id, from_status, to_status, actor, reason, created_at)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6)
+	`
+
+	_, err := tx.Exec(ctx, query,
+		change.OrderID, change.From, change.To, change.Actor, change.Reason, change.ChangedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert status history: %w", err)
+	}
+
+	// Delivered to listeners on commit only, see StatusListener.
+	_, err = tx.Exec(ctx, `SELECT pg_notify($1, $2)`, statusChannel, strconv.FormatInt(change.OrderID, 10))
+	if err != nil {
+		return fmt.Errorf("failed to notify status change: %w", err)
+	}
+
+	return nil
+}
+
+// nullTime scans a nullable timestamp, NULL becomes zero time.
+type nullTime time.Time
+
+func (t *nullTime) ScanTimestamptz(v pgtype.Timestamptz) error {
+	if !v.Valid {
+		*t = nullTime{}
+		return nil
+	}
+	*t = nullTime(v.Time)
+	return nil
+}
+
+// timestamptz stores zero time as NULL.
+func timestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: !t.IsZero()}
+}
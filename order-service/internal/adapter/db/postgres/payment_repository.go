@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/outbox/pgstore"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type PaymentRepository struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+}
+
+func NewPaymentRepository(pool *pgxpool.Pool, logger *zap.Logger) *PaymentRepository {
+	return &PaymentRepository{
+		pool:   pool,
+		logger: logger.Named("payment_repository"),
+	}
+}
+
+func (r *PaymentRepository) Create(ctx context.Context, payment *domain.Payment) (int64, error) {
+	query := `
+		INSERT INTO payments (order_id, user_id, amount, status, provider, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	var id int64
+	err := r.pool.QueryRow(ctx, query,
+		payment.OrderID, payment.UserID, payment.Amount, payment.Status, payment.Provider,
+		payment.CreatedAt, payment.UpdatedAt,
+	).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return 0, domain.ErrPaymentExists
+		}
+		r.logger.Error("failed to insert payment", zap.Int64("order_id", payment.OrderID), zap.Error(err))
+		return 0, fmt.Errorf("failed to insert payment: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *PaymentRepository) GetByOrderID(ctx context.Context, orderID int64) (*domain.Payment, error) {
+	query := `
+		SELECT id, order_id, user_id, amount, status, provider, COALESCE(provider_ref, ''),
+			failure_reason, created_at, updated_at
+		FROM payments
+		WHERE order_id = $1
+	`
+
+	var payment domain.Payment
+	err := r.pool.QueryRow(ctx, query, orderID).Scan(
+		&payment.ID,
+		&payment.OrderID,
+		&payment.UserID,
+		&payment.Amount,
+		&payment.Status,
+		&payment.Provider,
+		&payment.ProviderRef,
+		&payment.FailureReason,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("payment of order %d: %w", orderID, domain.ErrPaymentNotFound)
+		}
+		r.logger.Error("failed to get payment", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("get payment: %w", err)
+	}
+
+	return &payment, nil
+}
+
+func (r *PaymentRepository) Update(ctx context.Context, payment *domain.Payment,
+	from domain.PaymentStatus, events ...domain.PaymentEventFactory) error {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE payments
+		SET status = $3, provider_ref = NULLIF($4, ''), failure_reason = $5, updated_at = $6
+		WHERE id = $1 AND status = $2
+	`
+
+	tag, err := tx.Exec(ctx, query, payment.ID, from, payment.Status,
+		payment.ProviderRef, payment.FailureReason, payment.UpdatedAt)
+	if err != nil {
+		r.logger.Error("failed to update payment", zap.Int64("payment_id", payment.ID), zap.Error(err))
+		return fmt.Errorf("update payment: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		r.logger.Warn("payment was not updated",
+			zap.Int64("payment_id", payment.ID),
+			zap.String("from", string(from)),
+			zap.String("to", string(payment.Status)))
+		return domain.ErrPaymentStatusConflict
+	}
+
+	for _, event := range events {
+		msg, err := event(payment)
+		if err != nil {
+			return fmt.Errorf("failed to build outbox message: %w", err)
+		}
+
+		if err := pgstore.Insert(ctx, tx, msg); err != nil {
+			r.logger.Error("failed to insert outbox message", zap.Int64("payment_id", payment.ID), zap.Error(err))
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.logger.Info("payment updated",
+		zap.Int64("payment_id", payment.ID),
+		zap.Int64("order_id", payment.OrderID),
+		zap.String("status", string(payment.Status)))
+
+	return nil
+}
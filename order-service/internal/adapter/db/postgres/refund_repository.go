@@ -0,0 +1,236 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/outbox/pgstore"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type RefundRepository struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+}
+
+func NewRefundRepository(pool *pgxpool.Pool, logger *zap.Logger) *RefundRepository {
+	return &RefundRepository{
+		pool:   pool,
+		logger: logger.Named("refund_repository"),
+	}
+}
+
+func (r *RefundRepository) Create(ctx context.Context, refund *domain.Refund, refunded int64) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// The payment row lock serializes refunds of the order.
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM payments WHERE id = $1 FOR UPDATE`, refund.PaymentID); err != nil {
+		r.logger.Error("failed to lock payment", zap.Int64("payment_id", refund.PaymentID), zap.Error(err))
+		return 0, fmt.Errorf("lock payment: %w", err)
+	}
+
+	var current int64
+	err = tx.QueryRow(ctx,
+		`SELECT COALESCE(SUM(amount), 0) FROM refunds WHERE payment_id = $1 AND status <> $2`,
+		refund.PaymentID, domain.RefundFailed,
+	).Scan(&current)
+	if err != nil {
+		r.logger.Error("failed to sum refunds", zap.Int64("payment_id", refund.PaymentID), zap.Error(err))
+		return 0, fmt.Errorf("sum refunds: %w", err)
+	}
+
+	if current != refunded {
+		r.logger.Warn("refund ledger was changed",
+			zap.Int64("order_id", refund.OrderID),
+			zap.Int64("expected", refunded),
+			zap.Int64("actual", current))
+		return 0, domain.ErrRefundConflict
+	}
+
+	query := `
+		INSERT INTO refunds (order_id, payment_id, amount, reason, comment, actor, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id
+	`
+
+	var id int64
+	err = tx.QueryRow(ctx, query,
+		refund.OrderID, refund.PaymentID, refund.Amount, refund.Reason, refund.Comment, refund.Actor,
+		refund.Status, refund.CreatedAt, refund.UpdatedAt,
+	).Scan(&id)
+	if err != nil {
+		r.logger.Error("failed to insert refund", zap.Int64("order_id", refund.OrderID), zap.Error(err))
+		return 0, fmt.Errorf("failed to insert refund: %w", err)
+	}
+
+	// Ledger rows point to the refunded order items.
+	queryItem := `
+		INSERT INTO refund_items (refund_id, order_item_id, product_id, quantity, amount)
+		SELECT $1, id, product_id, $3, $4
+		FROM orders_items
+		WHERE order_id = $5 AND product_id = $2
+		ORDER BY id
+		LIMIT 1
+	`
+
+	for _, item := range refund.Items {
+		tag, err := tx.Exec(ctx, queryItem, id, item.ProductID, item.Quantity, item.Amount, refund.OrderID)
+		if err != nil {
+			r.logger.Error("failed to insert refund item", zap.Int64("refund_id", id), zap.Error(err))
+			return 0, fmt.Errorf("failed to insert refund item: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return 0, fmt.Errorf("order %d has no item of product %d", refund.OrderID, item.ProductID)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *RefundRepository) ListByOrderID(ctx context.Context, orderID int64) (domain.RefundLedger, error) {
+	query := `
+		SELECT id, order_id, payment_id, amount, reason, comment, actor, status,
+			COALESCE(provider_ref, ''), failure_reason, created_at, updated_at
+		FROM refunds
+		WHERE order_id = $1
+		ORDER BY id
+	`
+
+	rows, err := r.pool.Query(ctx, query, orderID)
+	if err != nil {
+		r.logger.Error("failed to get refunds", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("get refunds: %w", err)
+	}
+
+	ledger, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.Refund, error) {
+		var refund domain.Refund
+		err := row.Scan(
+			&refund.ID,
+			&refund.OrderID,
+			&refund.PaymentID,
+			&refund.Amount,
+			&refund.Reason,
+			&refund.Comment,
+			&refund.Actor,
+			&refund.Status,
+			&refund.ProviderRef,
+			&refund.FailureReason,
+			&refund.CreatedAt,
+			&refund.UpdatedAt,
+		)
+		return refund, err
+	})
+	if err != nil {
+		r.logger.Error("failed to scan refunds", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("scan refunds: %w", err)
+	}
+
+	if len(ledger) == 0 {
+		return ledger, nil
+	}
+
+	queryItems := `
+		SELECT ri.refund_id, ri.product_id, ri.quantity, ri.amount
+		FROM refund_items ri
+		JOIN refunds r ON r.id = ri.refund_id
+		WHERE r.order_id = $1
+		ORDER BY ri.id
+	`
+
+	rows, err = r.pool.Query(ctx, queryItems, orderID)
+	if err != nil {
+		r.logger.Error("failed to get refund items", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("get refund items: %w", err)
+	}
+
+	index := make(map[int64]int, len(ledger))
+	for i, refund := range ledger {
+		index[refund.ID] = i
+	}
+
+	var (
+		refundID int64
+		item     domain.RefundItem
+	)
+	_, err = pgx.ForEachRow(rows, []any{&refundID, &item.ProductID, &item.Quantity, &item.Amount}, func() error {
+		i := index[refundID]
+		ledger[i].Items = append(ledger[i].Items, item)
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("failed to scan refund items", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("scan refund items: %w", err)
+	}
+
+	return ledger, nil
+}
+
+func (r *RefundRepository) Complete(ctx context.Context, refund *domain.Refund, event domain.RefundEventFactory,
+	changes ...domain.StatusChange) error {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE refunds
+		SET status = $3, provider_ref = NULLIF($4, ''), failure_reason = $5, updated_at = $6
+		WHERE id = $1 AND status = $2
+	`
+
+	tag, err := tx.Exec(ctx, query, refund.ID, domain.RefundPending, refund.Status,
+		refund.ProviderRef, refund.FailureReason, refund.UpdatedAt)
+	if err != nil {
+		r.logger.Error("failed to update refund", zap.Int64("refund_id", refund.ID), zap.Error(err))
+		return fmt.Errorf("update refund: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		r.logger.Warn("refund is not pending", zap.Int64("refund_id", refund.ID))
+		return domain.ErrRefundConflict
+	}
+
+	for _, change := range changes {
+		if err := updateOrderStatus(ctx, tx, r.logger, change); err != nil {
+			return err
+		}
+	}
+
+	if event != nil {
+		msg, err := event(refund)
+		if err != nil {
+			return fmt.Errorf("failed to build outbox message: %w", err)
+		}
+
+		if err := pgstore.Insert(ctx, tx, msg); err != nil {
+			r.logger.Error("failed to insert outbox message", zap.Int64("refund_id", refund.ID), zap.Error(err))
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	r.logger.Info("refund completed",
+		zap.Int64("refund_id", refund.ID),
+		zap.Int64("order_id", refund.OrderID),
+		zap.String("status", string(refund.Status)))
+
+	return nil
+}
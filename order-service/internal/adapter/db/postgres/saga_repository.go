@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/outbox/pgstore"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type SagaRepository struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+}
+
+func NewSagaRepository(pool *pgxpool.Pool, logger *zap.Logger) *SagaRepository {
+	return &SagaRepository{
+		pool:   pool,
+		logger: logger.Named("saga_repository"),
+	}
+}
+
+func (r *SagaRepository) Create(ctx context.Context, saga *domain.Saga, commands []*domain.OutboxMessage) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO order_sagas (order_id, status, step, pending, failure_reason, attempts,
+			deadline_at, version, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err = tx.Exec(ctx, query,
+		saga.OrderID, saga.Status, saga.Step, pendingSteps(saga.Pending), saga.FailureReason, saga.Attempts,
+		timestamptz(saga.DeadlineAt), saga.Version, saga.CreatedAt, saga.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return domain.ErrSagaExists
+		}
+		r.logger.Error("failed to insert saga", zap.Int64("order_id", saga.OrderID), zap.Error(err))
+		return fmt.Errorf("failed to insert saga: %w", err)
+	}
+
+	if err := r.insertCommands(ctx, tx, saga, commands); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SagaRepository) Get(ctx context.Context, orderID int64) (*domain.Saga, error) {
+	query := `
+		SELECT order_id, status, step, pending, failure_reason, attempts,
+			deadline_at, version, created_at, updated_at
+		FROM order_sagas
+		WHERE order_id = $1
+	`
+
+	var (
+		saga    domain.Saga
+		pending []string
+	)
+	err := r.pool.QueryRow(ctx, query, orderID).Scan(
+		&saga.OrderID,
+		&saga.Status,
+		&saga.Step,
+		&pending,
+		&saga.FailureReason,
+		&saga.Attempts,
+		(*nullTime)(&saga.DeadlineAt),
+		&saga.Version,
+		&saga.CreatedAt,
+		&saga.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("saga of order %d: %w", orderID, domain.ErrSagaNotFound)
+		}
+		r.logger.Error("failed to get saga", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("get saga: %w", err)
+	}
+
+	for _, step := range pending {
+		saga.Pending = append(saga.Pending, domain.SagaStep(step))
+	}
+
+	return &saga, nil
+}
+
+func (r *SagaRepository) Update(ctx context.Context, saga *domain.Saga, messages []*domain.OutboxMessage,
+	changes ...domain.StatusChange) error {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE order_sagas
+		SET status = $3, step = $4, pending = $5, failure_reason = $6, attempts = $7,
+			deadline_at = $8, version = version + 1, updated_at = $9
+		WHERE order_id = $1 AND version = $2
+	`
+
+	tag, err := tx.Exec(ctx, query,
+		saga.OrderID, saga.Version, saga.Status, saga.Step, pendingSteps(saga.Pending), saga.FailureReason,
+		saga.Attempts, timestamptz(saga.DeadlineAt), saga.UpdatedAt,
+	)
+	if err != nil {
+		r.logger.Error("failed to update saga", zap.Int64("order_id", saga.OrderID), zap.Error(err))
+		return fmt.Errorf("update saga: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		r.logger.Warn("saga was not updated",
+			zap.Int64("order_id", saga.OrderID),
+			zap.Int64("version", saga.Version))
+		return domain.ErrSagaConflict
+	}
+
+	for _, change := range changes {
+		if err := updateOrderStatus(ctx, tx, r.logger, change); err != nil {
+			return err
+		}
+	}
+
+	if err := r.insertCommands(ctx, tx, saga, messages); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	saga.Version++
+	return nil
+}
+
+func (r *SagaRepository) Due(ctx context.Context, t time.Time, limit int) ([]int64, error) {
+	query := `
+		SELECT order_id
+		FROM order_sagas
+		WHERE status IN ($1, $2) AND deadline_at <= $3
+		ORDER BY deadline_at
+		LIMIT $4
+	`
+
+	rows, err := r.pool.Query(ctx, query, domain.SagaRunning, domain.SagaCompensating, t, limit)
+	if err != nil {
+		r.logger.Error("failed to select due sagas", zap.Error(err))
+		return nil, fmt.Errorf("select due sagas: %w", err)
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		r.logger.Error("failed to scan due sagas", zap.Error(err))
+		return nil, fmt.Errorf("scan due sagas: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *SagaRepository) insertCommands(ctx context.Context, tx pgx.Tx, saga *domain.Saga,
+	commands []*domain.OutboxMessage) error {
+
+	for _, msg := range commands {
+		if err := pgstore.Insert(ctx, tx, msg); err != nil {
+			r.logger.Error("failed to insert outbox message", zap.Int64("order_id", saga.OrderID), zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+func pendingSteps(steps []domain.SagaStep) []string {
+	pending := make([]string, 0, len(steps))
+	for _, step := range steps {
+		pending = append(pending, string(step))
+	}
+	return pending
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// statusChannel is the LISTEN/NOTIFY channel of order status changes, the
+// payload is the order id.
+const statusChannel = "order_status_changed"
+
+// StatusPublisher wakes up watchers of order statuses.
+type StatusPublisher interface {
+	Publish(orderID int64)
+	// Broadcast wakes up all watchers, e.g. when notifications may have been missed.
+	Broadcast()
+}
+
+// StatusListener forwards status change notifications of all instances to
+// the publisher. It holds a connection of its own while listening.
+type StatusListener struct {
+	pool       *pgxpool.Pool
+	publisher  StatusPublisher
+	retryDelay time.Duration
+	logger     *zap.Logger
+}
+
+func NewStatusListener(pool *pgxpool.Pool, publisher StatusPublisher,
+	retryDelay time.Duration, logger *zap.Logger) *StatusListener {
+	return &StatusListener{
+		pool:       pool,
+		publisher:  publisher,
+		retryDelay: retryDelay,
+		logger:     logger.Named("status_listener"),
+	}
+}
+
+func (l *StatusListener) Run(ctx context.Context) {
+	l.logger.Info("Status listener has been started")
+
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			l.logger.Info("Status listener stopped")
+			return
+		}
+		l.logger.Error("Status listener failed, reconnecting", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			l.logger.Info("Status listener stopped")
+			return
+		case <-time.After(l.retryDelay):
+		}
+	}
+}
+
+func (l *StatusListener) listen(ctx context.Context) error {
+	pooled, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+
+	// A listening connection must not go back to the pool.
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+statusChannel); err != nil {
+		return err
+	}
+
+	// Changes made while not listening are picked up by watchers on wake up.
+	l.publisher.Broadcast()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		orderID, err := strconv.ParseInt(notification.Payload, 10, 64)
+		if err != nil {
+			l.logger.Warn("Skip malformed status notification", zap.String("payload", notification.Payload))
+			continue
+		}
+
+		l.publisher.Publish(orderID)
+	}
+}
@@ -0,0 +1,62 @@
+package geocoder
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+)
+
+// streetSpread is how far from the city center streets are placed, in degrees (~5 km).
+const streetSpread = 0.05
+
+var defaultCities = map[string]geo.Point{
+	"moscow":           {Lat: 55.7558, Lng: 37.6173},
+	"москва":           {Lat: 55.7558, Lng: 37.6173},
+	"saint petersburg": {Lat: 59.9343, Lng: 30.3351},
+	"санкт-петербург":  {Lat: 59.9343, Lng: 30.3351},
+	"kazan":            {Lat: 55.7961, Lng: 49.1064},
+	"казань":           {Lat: 55.7961, Lng: 49.1064},
+	"novosibirsk":      {Lat: 55.0084, Lng: 82.9357},
+	"новосибирск":      {Lat: 55.0084, Lng: 82.9357},
+	"yekaterinburg":    {Lat: 56.8389, Lng: 60.6057},
+	"екатеринбург":     {Lat: 56.8389, Lng: 60.6057},
+}
+
+// OfflineGeocoder resolves addresses without network calls, it stands in for
+// a real geocoding provider in development and tests. The city is looked up in
+// a built-in table and the street is placed deterministically near its center,
+// so the same address always gets the same coordinates.
+type OfflineGeocoder struct {
+	cities map[string]geo.Point
+}
+
+func NewOfflineGeocoder() *OfflineGeocoder {
+	return &OfflineGeocoder{
+		cities: defaultCities,
+	}
+}
+
+func (g *OfflineGeocoder) Geocode(ctx context.Context, address domain.DeliveryAddress) (geo.Point, error) {
+	center, ok := g.cities[strings.ToLower(strings.TrimSpace(address.City))]
+	if !ok {
+		return geo.Point{}, fmt.Errorf("city %q: %w", address.City, domain.ErrAddressNotFound)
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(strings.ToLower(strings.TrimSpace(address.Street))))
+	sum := h.Sum64()
+
+	return geo.Point{
+		Lat: center.Lat + offset(uint32(sum)),
+		Lng: center.Lng + offset(uint32(sum>>32)),
+	}, nil
+}
+
+// offset maps v to [-streetSpread, streetSpread].
+func offset(v uint32) float64 {
+	return (float64(v)/float64(^uint32(0))*2 - 1) * streetSpread
+}
@@ -2,69 +2,88 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/events"
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 )
 
 type Producer struct {
 	writer *kafka.Writer
+	topics map[string]string
 	logger *zap.Logger
 }
 
-type OrderCreatedEvent struct {
-	OrderID   int64     `json:"order_id"`
-	UserID    int64     `json:"user_id"`
-	Timestamp time.Time `json:"timestamp"`
-}
-
 type Config struct {
 	Brokers         []string
 	Topic           string
+	CancelledTopic  string
+	PaymentsTopic   string
 	ProducerTimeout time.Duration
 	RequireAcks     int
+
+	// Saga commands and replies.
+	RestaurantCommandsTopic string
+	PaymentCommandsTopic    string
+	SagaRepliesTopic        string
 }
 
 func NewProducer(cfg Config, logger *zap.Logger) *Producer {
 
+	// Messages with the same key, e.g. the order id, go to one partition and stay in order.
 	writer := &kafka.Writer{
 		Addr:         kafka.TCP(cfg.Brokers...),
-		Topic:        cfg.Topic,
-		Balancer:     &kafka.RoundRobin{},
+		Balancer:     &kafka.Hash{},
 		WriteTimeout: cfg.ProducerTimeout,
 		RequiredAcks: kafka.RequiredAcks(cfg.RequireAcks),
 	}
 
 	return &Producer{
 		writer: writer,
+		// The payments topic feeds services outside order-service, e.g.
+		// notifications and accounting, order-service only publishes to it.
+		topics: map[string]string{
+			domain.EventOrderCreated:      cfg.Topic,
+			domain.EventOrderCancelled:    cfg.CancelledTopic,
+			domain.EventPaymentSucceeded:  cfg.PaymentsTopic,
+			domain.EventPaymentFailed:     cfg.PaymentsTopic,
+			domain.EventOrderRefunded:     cfg.PaymentsTopic,
+			domain.EventRestaurantCommand: cfg.RestaurantCommandsTopic,
+			domain.EventPaymentCommand:    cfg.PaymentCommandsTopic,
+			domain.EventSagaReply:         cfg.SagaRepliesTopic,
+		},
 		logger: logger.Named("kafka_producer"),
 	}
 
 }
 
-func (p *Producer) SentOrCreated(ctx context.Context, event OrderCreatedEvent) error {
-	valueBytes, err := json.Marshal(event)
-	if err != nil {
-		p.logger.Error("Failed to marshal event")
-		return err
-	}
-
-	msg := kafka.Message{
-		Key:   []byte(fmt.Sprintf("%d", event.UserID)),
-		Value: valueBytes,
+// Publish writes an outbox message to the topic of its event type.
+func (p *Producer) Publish(ctx context.Context, msg domain.OutboxMessage) error {
+	topic, ok := p.topics[msg.EventType]
+	if !ok {
+		return fmt.Errorf("no topic for event type %q", msg.EventType)
 	}
 
-	err = p.writer.WriteMessages(ctx, msg)
+	err := p.writer.WriteMessages(ctx, kafka.Message{
+		Topic: topic,
+		Key:   []byte(msg.Key),
+		Value: msg.Payload,
+		Headers: []kafka.Header{
+			{Key: "event_type", Value: []byte(msg.EventType)},
+			{Key: events.HeaderContentType, Value: []byte(msg.ContentType)},
+		},
+	})
 	if err != nil {
 		p.logger.Error("Failed to write message", zap.Error(err))
 		return err
 	}
 
-	p.logger.Info("OrderCreatedEvent sent to Kafka",
-		zap.Int64("order_id", event.OrderID),
+	p.logger.Info("Outbox message sent to Kafka",
+		zap.Int64("outbox_id", msg.ID),
+		zap.String("event_type", msg.EventType),
 	)
 
 	return nil
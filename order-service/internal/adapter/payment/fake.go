@@ -0,0 +1,129 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const FakeProvider = "fake"
+
+type fakeAuthorization struct {
+	amount   int64
+	captured bool
+	voided   bool
+	refunded int64
+}
+
+// FakeGateway is an in-process payment provider for development and tests.
+// It approves every payment up to declineOver, zero approves everything.
+// Authorizations live in memory and are lost on restart.
+type FakeGateway struct {
+	mu          sync.Mutex
+	declineOver int64
+	keys        map[string]string
+	auths       map[string]*fakeAuthorization
+	logger      *zap.Logger
+}
+
+func NewFakeGateway(declineOver int64, logger *zap.Logger) *FakeGateway {
+	return &FakeGateway{
+		declineOver: declineOver,
+		keys:        make(map[string]string),
+		auths:       make(map[string]*fakeAuthorization),
+		logger:      logger.Named("fake_psp"),
+	}
+}
+
+func (g *FakeGateway) Name() string {
+	return FakeProvider
+}
+
+func (g *FakeGateway) Authorize(_ context.Context, req domain.AuthorizeRequest) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if ref, ok := g.keys[req.IdempotencyKey]; ok {
+		return ref, nil
+	}
+
+	if g.declineOver > 0 && req.Amount > g.declineOver {
+		g.logger.Info("Payment declined", zap.Int64("order_id", req.OrderID), zap.Int64("amount", req.Amount))
+		return "", fmt.Errorf("%w: amount %d exceeds limit %d", domain.ErrPaymentDeclined, req.Amount, g.declineOver)
+	}
+
+	ref := "fake_" + uuid.NewString()
+	g.auths[ref] = &fakeAuthorization{amount: req.Amount}
+	if req.IdempotencyKey != "" {
+		g.keys[req.IdempotencyKey] = ref
+	}
+
+	g.logger.Info("Payment authorized", zap.Int64("order_id", req.OrderID), zap.String("provider_ref", ref))
+	return ref, nil
+}
+
+func (g *FakeGateway) Capture(_ context.Context, providerRef string, amount int64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	auth, ok := g.auths[providerRef]
+	switch {
+	case !ok:
+		return fmt.Errorf("fake psp: unknown authorization %q", providerRef)
+	case auth.voided:
+		return fmt.Errorf("fake psp: authorization %q is voided", providerRef)
+	case amount > auth.amount:
+		return fmt.Errorf("fake psp: capture %d exceeds authorized %d", amount, auth.amount)
+	}
+
+	auth.captured = true
+	return nil
+}
+
+func (g *FakeGateway) Void(_ context.Context, providerRef string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	auth, ok := g.auths[providerRef]
+	switch {
+	case !ok:
+		return fmt.Errorf("fake psp: unknown authorization %q", providerRef)
+	case auth.captured:
+		return fmt.Errorf("fake psp: authorization %q is already captured", providerRef)
+	}
+
+	auth.voided = true
+	return nil
+}
+
+func (g *FakeGateway) Refund(_ context.Context, providerRef string, amount int64, idempotencyKey string) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if ref, ok := g.keys[idempotencyKey]; ok {
+		return ref, nil
+	}
+
+	auth, ok := g.auths[providerRef]
+	switch {
+	case !ok:
+		return "", fmt.Errorf("fake psp: unknown authorization %q", providerRef)
+	case !auth.captured:
+		return "", fmt.Errorf("fake psp: authorization %q is not captured", providerRef)
+	case auth.refunded+amount > auth.amount:
+		return "", fmt.Errorf("fake psp: refund %d exceeds captured %d, refunded %d", amount, auth.amount, auth.refunded)
+	}
+
+	auth.refunded += amount
+	ref := "fake_refund_" + uuid.NewString()
+	if idempotencyKey != "" {
+		g.keys[idempotencyKey] = ref
+	}
+
+	g.logger.Info("Payment refunded", zap.String("provider_ref", providerRef), zap.Int64("amount", amount))
+	return ref, nil
+}
@@ -0,0 +1,59 @@
+package restaurant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	restaurantpb "github.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AvailabilityChecker asks restaurant-service whether a restaurant accepts orders.
+type AvailabilityChecker struct {
+	client  restaurantpb.RestaurantServiceClient
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+func NewAvailabilityChecker(client restaurantpb.RestaurantServiceClient,
+	timeout time.Duration, logger *zap.Logger) *AvailabilityChecker {
+	return &AvailabilityChecker{
+		client:  client,
+		timeout: timeout,
+		logger:  logger.Named("availability_checker"),
+	}
+}
+
+// CheckOpen returns domain.ErrRestaurantClosed if the restaurant is closed or
+// paused at the given moment. The error tells when it opens next.
+func (c *AvailabilityChecker) CheckOpen(ctx context.Context, restaurantID int64, at time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.client.GetRestaurantStatus(ctx, &restaurantpb.GetRestaurantStatusRequest{
+		RestaurantId: restaurantID,
+		At:           timestamppb.New(at),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return fmt.Errorf("restaurant %d: %w", restaurantID, domain.ErrRestaurantNotFound)
+		}
+		c.logger.Error("Failed to get restaurant status", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return fmt.Errorf("get restaurant status: %w", err)
+	}
+
+	if resp.Open {
+		return nil
+	}
+
+	if resp.NextOpenAt != nil {
+		return fmt.Errorf("%w: %s, opens at %s", domain.ErrRestaurantClosed,
+			resp.Reason, resp.NextOpenAt.AsTime().Format(time.RFC3339))
+	}
+	return fmt.Errorf("%w: %s", domain.ErrRestaurantClosed, resp.Reason)
+}
@@ -0,0 +1,56 @@
+package restaurant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+	restaurantpb "github.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeliveryChecker checks addresses against restaurant delivery zones in restaurant-service.
+type DeliveryChecker struct {
+	client  restaurantpb.RestaurantServiceClient
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+func NewDeliveryChecker(client restaurantpb.RestaurantServiceClient, timeout time.Duration, logger *zap.Logger) *DeliveryChecker {
+	return &DeliveryChecker{
+		client:  client,
+		timeout: timeout,
+		logger:  logger.Named("delivery_checker"),
+	}
+}
+
+// CheckDeliverable returns domain.ErrAddressNotServed if point is outside of
+// restaurant delivery radius and zones.
+func (c *DeliveryChecker) CheckDeliverable(ctx context.Context, restaurantID int64, point geo.Point) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.client.CheckDeliverable(ctx, &restaurantpb.CheckDeliverableRequest{
+		RestaurantId: restaurantID,
+		Lat:          point.Lat,
+		Lng:          point.Lng,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return fmt.Errorf("restaurant %d: %w", restaurantID, domain.ErrRestaurantNotFound)
+		}
+		c.logger.Error("Failed to check delivery", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return fmt.Errorf("check delivery: %w", err)
+	}
+
+	if !resp.Deliverable {
+		return fmt.Errorf("%w: %.0f m away from restaurant %d",
+			domain.ErrAddressNotServed, resp.DistanceM, restaurantID)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package restaurant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	restaurantpb "github.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MenuPricer prices order items from the restaurant menu served by restaurant-service.
+type MenuPricer struct {
+	client  restaurantpb.RestaurantServiceClient
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+func NewMenuPricer(client restaurantpb.RestaurantServiceClient, timeout time.Duration, logger *zap.Logger) *MenuPricer {
+	return &MenuPricer{
+		client:  client,
+		timeout: timeout,
+		logger:  logger.Named("menu_pricer"),
+	}
+}
+
+// Prices returns price per product id. Every requested product must be on the
+// menu and available, otherwise domain.ErrProductUnavailable is returned.
+func (p *MenuPricer) Prices(ctx context.Context, restaurantID int64, productIDs []int64) (map[int64]int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	resp, err := p.client.GetMenu(ctx, &restaurantpb.GetMenuRequest{RestaurantId: restaurantID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("restaurant %d: %w", restaurantID, domain.ErrRestaurantNotFound)
+		}
+		p.logger.Error("Failed to get menu", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, fmt.Errorf("get menu: %w", err)
+	}
+
+	menu := make(map[int64]*restaurantpb.MenuItem, len(resp.Items))
+	for _, item := range resp.Items {
+		menu[item.ProductId] = item
+	}
+
+	prices := make(map[int64]int64, len(productIDs))
+	for _, productID := range productIDs {
+		item, ok := menu[productID]
+		if !ok || !item.IsAvailable {
+			return nil, fmt.Errorf("product %d: %w", productID, domain.ErrProductUnavailable)
+		}
+		prices[productID] = item.Price
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,44 @@
+package restaurant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	restaurantpb "github.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PrepTimer reads restaurant kitchen prep time from restaurant-service.
+type PrepTimer struct {
+	client  restaurantpb.RestaurantServiceClient
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+func NewPrepTimer(client restaurantpb.RestaurantServiceClient, timeout time.Duration, logger *zap.Logger) *PrepTimer {
+	return &PrepTimer{
+		client:  client,
+		timeout: timeout,
+		logger:  logger.Named("prep_timer"),
+	}
+}
+
+func (p *PrepTimer) PrepTime(ctx context.Context, restaurantID int64) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	resp, err := p.client.GetRestaurant(ctx, &restaurantpb.GetRestaurantRequest{RestaurantId: restaurantID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return 0, fmt.Errorf("restaurant %d: %w", restaurantID, domain.ErrRestaurantNotFound)
+		}
+		p.logger.Error("Failed to get restaurant", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return 0, fmt.Errorf("get restaurant: %w", err)
+	}
+
+	return time.Duration(resp.Restaurant.GetPrepTimeMinutes()) * time.Minute, nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -15,16 +16,25 @@ import (
 	"google.golang.org/grpc"
 )
 
+// Worker is a background process that runs until ctx is cancelled.
+type Worker interface {
+	Run(ctx context.Context)
+}
+
 type App struct {
 	cfg        *config.Config
 	logger     *zap.Logger
 	grpcServer *grpc.Server
 	httpServer *http.Server
+	workers    []Worker
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
 }
 
 func NewApp(cfg *config.Config,
 	logger *zap.Logger,
-	grpcServer *grpc.Server) *App {
+	grpcServer *grpc.Server,
+	workers ...Worker) *App {
 
 	httpServer := &http.Server{
 		Addr:              ":" + cfg.PrometheusPort,
@@ -38,6 +48,7 @@ func NewApp(cfg *config.Config,
 		logger:     logger,
 		grpcServer: grpcServer,
 		httpServer: httpServer,
+		workers:    workers,
 	}
 }
 
@@ -61,6 +72,16 @@ func (a *App) Run() {
 		}
 	}()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancel = cancel
+	for _, w := range a.workers {
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			w.Run(ctx)
+		}()
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
@@ -75,16 +96,40 @@ func (a *App) Stop() {
 
 	const timeOut = 5 * time.Second
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeOut)
-	defer cancel()
-
 	a.logger.Info("Stopping grpc server...")
-	a.grpcServer.GracefulStop()
+	a.stopGRPC(timeOut)
+
+	a.logger.Info("Stopping background workers...")
+	if a.cancel != nil {
+		a.cancel()
+	}
+	a.wg.Wait()
 
 	a.logger.Info("Stoppong HTTP server...")
+	// The grpc server may have used the whole timeout, the HTTP server gets its own.
+	ctx, cancel := context.WithTimeout(context.Background(), timeOut)
+	defer cancel()
 	if err := a.httpServer.Shutdown(ctx); err != nil {
 		a.logger.Warn("HTTP server shutdown error", zap.Error(err))
 	}
 
 	a.logger.Info("Application stopped")
 }
+
+// stopGRPC waits for running calls to finish and closes the server after
+// timeout. Open streams like WatchOrder only end when the server closes them.
+func (a *App) stopGRPC(timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		a.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		a.logger.Warn("Grpc server did not stop in time, closing open streams")
+		a.grpcServer.Stop()
+		<-stopped
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Wuchinator/food-delivery/pkg/events"
 	"github.com/joho/godotenv"
 )
 
@@ -19,6 +20,12 @@ type Config struct {
 	PrometheusPort string
 	Postgres       PostgresConfig
 	Kafka          KafkaConfig
+	Restaurant     RestaurantConfig
+	Outbox         OutboxConfig
+	Scheduler      SchedulerConfig
+	Payment        PaymentConfig
+	Saga           SagaConfig
+	Watch          WatchConfig
 }
 type PostgresConfig struct {
 	Host            string
@@ -35,8 +42,70 @@ type PostgresConfig struct {
 type KafkaConfig struct {
 	Brokers         []string
 	Topic           string
+	CancelledTopic  string
+	PaymentsTopic   string
 	ProducerTimeout time.Duration
 	RequireAcks     int
+	EventFormat     events.Format
+
+	// Order saga commands to participants and their replies.
+	RestaurantCommandsTopic string
+	PaymentCommandsTopic    string
+	SagaRepliesTopic        string
+
+	GroupID            string
+	PaymentsGroupID    string
+	SagaGroupID        string
+	KitchenStatusTopic string
+	// DeliveryStatusTopic carries pickup and delivery of orders by couriers.
+	DeliveryStatusTopic string
+	ConsumerTimeout     time.Duration
+
+	// Consumer retries, failed messages go to a dead-letter topic per group and topic.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	Workers        int
+	QueueSize      int
+	CommitInterval time.Duration
+	DrainTimeout   time.Duration
+}
+
+type RestaurantConfig struct {
+	Addr    string
+	Timeout time.Duration
+}
+
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+type SchedulerConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+type SagaConfig struct {
+	StepTimeout             time.Duration
+	MaxCompensationAttempts int
+	PollInterval            time.Duration
+	BatchSize               int
+}
+
+type WatchConfig struct {
+	// PollInterval rereads watched orders in case a status notification was lost.
+	PollInterval time.Duration
+	// ListenRetryDelay is the pause before the status listener reconnects.
+	ListenRetryDelay time.Duration
+}
+
+type PaymentConfig struct {
+	// Provider is the payment gateway, only "fake" is supported for now.
+	Provider string
+	// FakeDeclineOver makes the fake provider decline larger amounts, zero approves everything.
+	FakeDeclineOver int64
 }
 
 func Load() (*Config, error) {
@@ -63,17 +132,87 @@ func Load() (*Config, error) {
 		SSLMode:         getEnv("POSTGRES_SSL_MODE", "disable"),
 	}
 
+	eventFormat, err := events.ParseFormat(getEnv("KAFKA_EVENT_FORMAT", string(events.FormatJSON)))
+	if err != nil {
+		return nil, err
+	}
+
 	brokers := getEnv("KAFKA_BROKERS", "localhost:9092")
 	cfg.Kafka = KafkaConfig{
 		Brokers:         strings.Split(brokers, ","),
 		Topic:           getEnv("KAFKA_TOPIC_ORDER", "user-order"),
+		CancelledTopic:  getEnv("KAFKA_TOPIC_ORDER_CANCELLED", "user-order-cancelled"),
+		PaymentsTopic:   getEnv("KAFKA_TOPIC_PAYMENTS", "payments"),
 		ProducerTimeout: getEnvAsDuration("KAFKA_PRODUCER_TIMEOUT", time.Second*15),
 		RequireAcks:     getEnvAsInt("KAFKA_REQUIRED_ACKS", -1),
+		EventFormat:     eventFormat,
+
+		RestaurantCommandsTopic: getEnv("KAFKA_TOPIC_RESTAURANT_COMMANDS", "restaurant-commands"),
+		PaymentCommandsTopic:    getEnv("KAFKA_TOPIC_PAYMENT_COMMANDS", "payment-commands"),
+		SagaRepliesTopic:        getEnv("KAFKA_TOPIC_SAGA_REPLIES", "saga-replies"),
+
+		GroupID:             getEnv("KAFKA_GROUP_ID", "order-service"),
+		PaymentsGroupID:     getEnv("KAFKA_PAYMENTS_GROUP_ID", "order-service-payments"),
+		SagaGroupID:         getEnv("KAFKA_SAGA_GROUP_ID", "order-service-saga"),
+		KitchenStatusTopic:  getEnv("KAFKA_TOPIC_KITCHEN_STATUS", "kitchen-status"),
+		DeliveryStatusTopic: getEnv("KAFKA_TOPIC_DELIVERY_STATUS", "delivery-status"),
+		ConsumerTimeout:     getEnvAsDuration("KAFKA_CONSUMER_TIMEOUT", 30*time.Second),
+
+		MaxAttempts:    getEnvAsInt("KAFKA_MAX_ATTEMPTS", 5),
+		InitialBackoff: getEnvAsDuration("KAFKA_INITIAL_BACKOFF", 200*time.Millisecond),
+		MaxBackoff:     getEnvAsDuration("KAFKA_MAX_BACKOFF", 10*time.Second),
+
+		Workers:        getEnvAsInt("KAFKA_CONSUMER_WORKERS", 1),
+		QueueSize:      getEnvAsInt("KAFKA_CONSUMER_QUEUE_SIZE", 64),
+		CommitInterval: getEnvAsDuration("KAFKA_COMMIT_INTERVAL", time.Second),
+		DrainTimeout:   getEnvAsDuration("KAFKA_DRAIN_TIMEOUT", 10*time.Second),
+	}
+
+	cfg.Restaurant = RestaurantConfig{
+		Addr:    getEnv("RESTAURANT_SERVICE_ADDR", "restaurant-service:50051"),
+		Timeout: getEnvAsDuration("RESTAURANT_SERVICE_TIMEOUT", 3*time.Second),
+	}
+
+	cfg.Outbox = OutboxConfig{
+		PollInterval: getEnvAsDuration("OUTBOX_POLL_INTERVAL", time.Second),
+		BatchSize:    getEnvAsInt("OUTBOX_BATCH_SIZE", 100),
+	}
+
+	cfg.Scheduler = SchedulerConfig{
+		PollInterval: getEnvAsDuration("SCHEDULER_POLL_INTERVAL", 15*time.Second),
+		BatchSize:    getEnvAsInt("SCHEDULER_BATCH_SIZE", 50),
+	}
+
+	cfg.Saga = SagaConfig{
+		StepTimeout:             getEnvAsDuration("SAGA_STEP_TIMEOUT", 30*time.Second),
+		MaxCompensationAttempts: getEnvAsInt("SAGA_MAX_COMPENSATION_ATTEMPTS", 5),
+		PollInterval:            getEnvAsDuration("SAGA_POLL_INTERVAL", 5*time.Second),
+		BatchSize:               getEnvAsInt("SAGA_BATCH_SIZE", 50),
+	}
+
+	cfg.Watch = WatchConfig{
+		PollInterval:     getEnvAsDuration("WATCH_POLL_INTERVAL", 30*time.Second),
+		ListenRetryDelay: getEnvAsDuration("WATCH_LISTEN_RETRY_DELAY", 5*time.Second),
+	}
+
+	cfg.Payment = PaymentConfig{
+		Provider:        getEnv("PAYMENT_PROVIDER", "fake"),
+		FakeDeclineOver: int64(getEnvAsInt("FAKE_PSP_DECLINE_OVER", 0)),
+	}
+	if cfg.Payment.Provider != "fake" {
+		return nil, fmt.Errorf("unknown payment provider %q", cfg.Payment.Provider)
 	}
 
 	return cfg, nil
 }
 
+// DLQTopic names the dead-letter topic of a consumer group, e.g.
+// "order-service-payments.kitchen-status.dlq". Several groups read the same
+// topics, so each one gets its own.
+func (cfg *KafkaConfig) DLQTopic(groupID, topic string) string {
+	return groupID + "." + topic + ".dlq"
+}
+
 func (cfg *PostgresConfig) PostgresDSN() string {
 	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
 		cfg.User,
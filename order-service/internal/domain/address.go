@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+)
+
+const (
+	maxStreetLength    = 256
+	maxCityLength      = 128
+	maxApartmentLength = 32
+	maxCommentLength   = 512
+)
+
+// DeliveryAddress is where the order is delivered. Lat and Lng are filled by
+// the client or by a Geocoder, zero coordinates mean "not geocoded yet".
+type DeliveryAddress struct {
+	Street    string
+	Apartment string
+	City      string
+	Lat       float64
+	Lng       float64
+	Comment   string
+}
+
+func (a DeliveryAddress) Point() geo.Point {
+	return geo.Point{Lat: a.Lat, Lng: a.Lng}
+}
+
+func (a DeliveryAddress) HasLocation() bool {
+	return a.Lat != 0 || a.Lng != 0
+}
+
+// Normalize trims all text fields.
+func (a DeliveryAddress) Normalize() DeliveryAddress {
+	a.Street = strings.TrimSpace(a.Street)
+	a.Apartment = strings.TrimSpace(a.Apartment)
+	a.City = strings.TrimSpace(a.City)
+	a.Comment = strings.TrimSpace(a.Comment)
+	return a
+}
+
+// String formats the address as a single line, e.g. "Tverskaya 1, apt. 5, Moscow".
+func (a DeliveryAddress) String() string {
+	parts := make([]string, 0, 3)
+	if a.Street != "" {
+		parts = append(parts, a.Street)
+	}
+	if a.Apartment != "" {
+		parts = append(parts, "apt. "+a.Apartment)
+	}
+	if a.City != "" {
+		parts = append(parts, a.City)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// validate adds address violations under the given field prefix.
+func (a DeliveryAddress) validate(prefix string, violations *ValidationError) {
+	a = a.Normalize()
+
+	checkText := func(field, value string, required bool, maxLength int) {
+		length := utf8.RuneCountInString(value)
+		switch {
+		case length == 0 && required:
+			violations.AddErr(prefix+"."+field, fmt.Errorf("%w: %s must not be empty", ErrInvalidAddress, field))
+		case length > maxLength:
+			violations.AddErr(prefix+"."+field,
+				fmt.Errorf("%w: %s must be at most %d characters long", ErrInvalidAddress, field, maxLength))
+		case !utf8.ValidString(value) || strings.IndexFunc(value, unicode.IsControl) >= 0:
+			violations.AddErr(prefix+"."+field,
+				fmt.Errorf("%w: %s must not contain control characters", ErrInvalidAddress, field))
+		}
+	}
+
+	checkText("street", a.Street, true, maxStreetLength)
+	checkText("city", a.City, true, maxCityLength)
+	checkText("apartment", a.Apartment, false, maxApartmentLength)
+	checkText("comment", a.Comment, false, maxCommentLength)
+
+	if a.Street != "" && strings.IndexFunc(a.Street, unicode.IsLetter) < 0 {
+		violations.AddErr(prefix+".street", fmt.Errorf("%w: street must contain a name", ErrInvalidAddress))
+	}
+
+	if a.HasLocation() && !a.Point().Valid() {
+		violations.AddErr(prefix+".lat", fmt.Errorf("%w: coordinates are out of range", ErrInvalidAddress))
+	}
+}
@@ -1 +1,52 @@
 package domain
+
+import (
+	"errors"
+
+	"github.com/Wuchinator/food-delivery/pkg/validation"
+)
+
+var (
+	ErrOrderNotFound       = errors.New("order not found")
+	ErrOrderNotCancellable = errors.New("order can not be cancelled in current status")
+	ErrOrderStatusConflict = errors.New("order status was changed concurrently")
+	ErrInvalidTransition   = errors.New("invalid order status transition")
+	ErrUnknownStatus       = errors.New("unknown order status")
+	ErrProductUnavailable  = errors.New("product is unknown or unavailable")
+	ErrRestaurantNotFound  = errors.New("restaurant not found")
+	ErrRestaurantClosed    = errors.New("restaurant does not accept orders now")
+	ErrIdempotencyConflict = errors.New("order with this idempotency key already exists")
+	ErrIdempotencyKeyReuse = errors.New("idempotency key was already used with a different request")
+	ErrEmptyItems          = errors.New("order must contain at least one item")
+	ErrTooManyItems        = errors.New("order contains too many items")
+	ErrInvalidAddress      = errors.New("invalid delivery address")
+	ErrAddressNotFound     = errors.New("delivery address not found")
+	ErrAddressNotServed    = errors.New("delivery address is outside of restaurant delivery area")
+	ErrInvalidArgument     = validation.ErrInvalidArgument
+
+	ErrPaymentNotFound       = errors.New("payment not found")
+	ErrPaymentExists         = errors.New("order already has a payment")
+	ErrPaymentDeclined       = errors.New("payment declined")
+	ErrPaymentStatusConflict = errors.New("payment status was changed concurrently")
+
+	ErrOrderNotRefundable    = errors.New("order can not be refunded in current status")
+	ErrPaymentNotCaptured    = errors.New("order has no captured payment")
+	ErrRefundExceedsCaptured = errors.New("refund exceeds captured amount")
+	ErrRefundConflict        = errors.New("order was refunded concurrently")
+
+	ErrSagaNotFound   = errors.New("order saga not found")
+	ErrSagaExists     = errors.New("order saga already exists")
+	ErrSagaConflict   = errors.New("order saga was changed concurrently")
+	ErrSagaStaleReply = errors.New("saga does not wait for this reply")
+)
+
+// FieldViolation describes why a single request field is invalid.
+type FieldViolation = validation.FieldViolation
+
+// ValidationError collects all invalid fields of a request. It matches
+// ErrInvalidArgument and errors of its violations.
+type ValidationError = validation.Error
+
+func NewValidationError(field, description string) *ValidationError {
+	return validation.New(field, description)
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// EstimateDelivery returns when the order is expected at the customer right
+// after the change, given the usual kitchen prep time of the restaurant.
+// Zero time means there is nothing to deliver anymore. Scheduled orders are
+// never expected before the requested time.
+func EstimateDelivery(order *Order, change StatusChange, prepTime time.Duration) time.Time {
+	var eta time.Time
+	switch change.To {
+	case OrderScheduled:
+		return order.ScheduledFor
+	case OrderCreated, OrperPaid, OrderAccepted, OrderPreparing:
+		eta = change.ChangedAt.Add(prepTime + DeliveryLeadTime)
+	case OrderReadyForPickup, OrderPickedUp:
+		eta = change.ChangedAt.Add(DeliveryLeadTime)
+	default:
+		return time.Time{}
+	}
+
+	if order.IsScheduled() && eta.Before(order.ScheduledFor) {
+		return order.ScheduledFor
+	}
+	return eta
+}
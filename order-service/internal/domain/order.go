@@ -2,25 +2,27 @@ package domain
 
 import (
 	"context"
+	"fmt"
 	"time"
 )
 
-type OrderStatus string
-
-const (
-	OrderCreated   OrderStatus = "Created"
-	OrperPaid      OrderStatus = "Paid"
-	OrderCancelled OrderStatus = "Cancelled"
-)
-
 type Order struct {
-	ID           int64
-	UserID       int64
-	RestaurantID int64
-	Items        []OrderItem
-	Status       OrderStatus
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID              int64
+	UserID          int64
+	RestaurantID    int64
+	Items           []OrderItem
+	Total           int64
+	Status          OrderStatus
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeliveryAddress DeliveryAddress
+	// ScheduledFor is the requested delivery time, zero for ASAP orders.
+	// ReleaseAt is when a scheduled order is handed over to the kitchen.
+	ScheduledFor time.Time
+	ReleaseAt    time.Time
+	// IdempotencyKey and RequestHash are set when client supplied idempotency key.
+	IdempotencyKey string
+	RequestHash    string
 }
 
 type OrderItem struct {
@@ -29,20 +31,80 @@ type OrderItem struct {
 	Price     int64
 }
 
+// Item returns the item of the product or nil if the order does not contain it.
+func (o *Order) Item(productID int64) *OrderItem {
+	for i := range o.Items {
+		if o.Items[i].ProductID == productID {
+			return &o.Items[i]
+		}
+	}
+	return nil
+}
+
+// OrderFilter describes a page of orders. Zero values mean "no filter".
+// Pages are ordered by id descending, AfterID is the last id of previous page.
+type OrderFilter struct {
+	UserID       int64
+	RestaurantID int64
+	Status       OrderStatus
+	CreatedFrom  time.Time
+	CreatedTo    time.Time
+	AfterID      int64
+	Limit        int
+}
+
 type OrderRepository interface {
-	Create(ctx context.Context, order *Order) (int64, error)
+	// Create stores the order and, if event is not nil, its outbox message in one transaction.
+	// Returns ErrIdempotencyConflict if the user already has an order with the same idempotency key.
+	Create(ctx context.Context, order *Order, event EventFactory) (int64, error)
+	GetByIdempotencyKey(ctx context.Context, userID int64, key string) (*Order, error)
 	GetByID(ctx context.Context, id int64) (*Order, error)
+	List(ctx context.Context, filter OrderFilter) ([]Order, error)
+	// UpdateStatus applies the change, records it in status history and stores
+	// the outbox messages in one transaction. Returns ErrOrderStatusConflict if
+	// the order is no longer in status change.From.
+	UpdateStatus(ctx context.Context, change StatusChange, events ...*OutboxMessage) error
+	History(ctx context.Context, orderID int64) ([]StatusChange, error)
+	// DueScheduled returns ids of scheduled orders with release time not after t,
+	// earliest first.
+	DueScheduled(ctx context.Context, t time.Time, limit int) ([]int64, error)
+	// Release applies the change of a scheduled order and stores its outbox
+	// message in one transaction. Returns ErrOrderStatusConflict like UpdateStatus.
+	Release(ctx context.Context, order *Order, change StatusChange, event EventFactory) error
 }
 
-func NewOrder(userID, restaurantID int64, items []OrderItem) (*Order, error) { // Пока без проверок
+// NewOrder validates the order, see ValidateOrder, and merges duplicate products.
+func NewOrder(userID, restaurantID int64, items []OrderItem, address DeliveryAddress) (*Order, error) {
+	if err := ValidateOrder(userID, restaurantID, items, address); err != nil {
+		return nil, err
+	}
+
+	items = MergeItems(items)
+
+	var total int64
+	for _, item := range items {
+		total += item.Price * int64(item.Quantity)
+	}
 
 	now := time.Now()
 	return &Order{
 		UserID:       userID,
 		RestaurantID: restaurantID,
 		Items:        items,
+		Total:        total,
 		Status:       OrderCreated,
 		CreatedAt:    now,
 		UpdatedAt:    now,
+
+		DeliveryAddress: address.Normalize(),
 	}, nil
 }
+
+// Cancel moves the order into OrderCancelled. Only orders that are not yet
+// handed over to the kitchen can be cancelled.
+func (o *Order) Cancel() error {
+	if err := o.Transition(OrderCancelled); err != nil {
+		return fmt.Errorf("%w: %w", ErrOrderNotCancellable, err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/Wuchinator/food-delivery/pkg/outbox"
+)
+
+const (
+	EventOrderCreated   = "OrderCreated"
+	EventOrderCancelled = "OrderCancelled"
+	// Saga commands and replies, see Saga.
+	EventRestaurantCommand = "RestaurantCommand"
+	EventPaymentCommand    = "PaymentCommand"
+	EventSagaReply         = "SagaReply"
+)
+
+// OutboxMessage is an event stored in the same transaction as the state change
+// and published to Kafka later by the outbox relay.
+type OutboxMessage = outbox.Message
+
+// EventFactory builds the outbox message for an order after it got its id.
+type EventFactory func(order *Order) (*OutboxMessage, error)
+
+type OutboxRepository interface {
+	outbox.Store
+	// Enqueue stores a message that is not tied to any other state change.
+	Enqueue(ctx context.Context, msg *OutboxMessage) error
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentPending    PaymentStatus = "Pending"
+	PaymentAuthorized PaymentStatus = "Authorized"
+	PaymentCaptured   PaymentStatus = "Captured"
+	PaymentFailed     PaymentStatus = "Failed"
+	PaymentVoided     PaymentStatus = "Voided"
+)
+
+const (
+	EventPaymentSucceeded = "PaymentSucceeded"
+	EventPaymentFailed    = "PaymentFailed"
+)
+
+// paymentTransitions: Pending -> Authorized -> Captured, a declined payment
+// ends in Failed and an authorization that is not needed anymore in Voided.
+var paymentTransitions = map[PaymentStatus][]PaymentStatus{
+	PaymentPending:    {PaymentAuthorized, PaymentFailed},
+	PaymentAuthorized: {PaymentCaptured, PaymentVoided},
+	PaymentCaptured:   {},
+	PaymentFailed:     {},
+	PaymentVoided:     {},
+}
+
+// Payment is the order total charged through a payment provider. Amounts are
+// in minor currency units like order totals.
+type Payment struct {
+	ID      int64
+	OrderID int64
+	UserID  int64
+	Amount  int64
+	Status  PaymentStatus
+	// Provider is the gateway name, ProviderRef the id of the authorization on its side.
+	Provider      string
+	ProviderRef   string
+	FailureReason string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewPayment(order *Order, provider string) *Payment {
+	now := time.Now()
+	return &Payment{
+		OrderID:   order.ID,
+		UserID:    order.UserID,
+		Amount:    order.Total,
+		Status:    PaymentPending,
+		Provider:  provider,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (p *Payment) transition(to PaymentStatus) error {
+	for _, next := range paymentTransitions[p.Status] {
+		if next == to {
+			p.Status = to
+			p.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: payment can not move from %s to %s", ErrInvalidTransition, p.Status, to)
+}
+
+func (p *Payment) Authorize(providerRef string) error {
+	if err := p.transition(PaymentAuthorized); err != nil {
+		return err
+	}
+	p.ProviderRef = providerRef
+	return nil
+}
+
+func (p *Payment) Fail(reason string) error {
+	if err := p.transition(PaymentFailed); err != nil {
+		return err
+	}
+	p.FailureReason = reason
+	return nil
+}
+
+func (p *Payment) Capture() error {
+	return p.transition(PaymentCaptured)
+}
+
+func (p *Payment) Void() error {
+	return p.transition(PaymentVoided)
+}
+
+// PaymentEventFactory builds the outbox message for a payment after it got its id.
+type PaymentEventFactory func(payment *Payment) (*OutboxMessage, error)
+
+type PaymentRepository interface {
+	// Create stores a pending payment. Returns ErrPaymentExists if the order
+	// already has one.
+	Create(ctx context.Context, payment *Payment) (int64, error)
+	// GetByOrderID returns ErrPaymentNotFound if the order has no payment.
+	GetByOrderID(ctx context.Context, orderID int64) (*Payment, error)
+	// Update saves status, provider reference and failure reason of the payment
+	// and outbox messages of events in one transaction. Returns
+	// ErrPaymentStatusConflict if the payment is no longer in status from.
+	Update(ctx context.Context, payment *Payment, from PaymentStatus, events ...PaymentEventFactory) error
+}
+
+// AuthorizeRequest asks the provider to hold Amount on the customer's card.
+// IdempotencyKey makes retries of the same request safe.
+type AuthorizeRequest struct {
+	OrderID        int64
+	UserID         int64
+	Amount         int64
+	IdempotencyKey string
+}
+
+// PaymentGateway is a payment service provider. Authorize fails with
+// ErrPaymentDeclined if the provider refuses the payment.
+type PaymentGateway interface {
+	Name() string
+	Authorize(ctx context.Context, req AuthorizeRequest) (providerRef string, err error)
+	Capture(ctx context.Context, providerRef string, amount int64) error
+	Void(ctx context.Context, providerRef string) error
+	// Refund returns amount of a captured payment, IdempotencyKey makes retries
+	// safe. Returns the id of the refund on the provider side.
+	Refund(ctx context.Context, providerRef string, amount int64, idempotencyKey string) (string, error)
+}
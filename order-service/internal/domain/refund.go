@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RefundReason is the reason code support picks for a refund. There is no code
+// for cancelled orders: their payment is only authorized and the saga voids it.
+type RefundReason string
+
+const (
+	RefundMissingItem  RefundReason = "missing_item"
+	RefundWrongItem    RefundReason = "wrong_item"
+	RefundQualityIssue RefundReason = "quality_issue"
+	RefundLateDelivery RefundReason = "late_delivery"
+	RefundOther        RefundReason = "other"
+)
+
+func (r RefundReason) IsValid() bool {
+	switch r {
+	case RefundMissingItem, RefundWrongItem, RefundQualityIssue,
+		RefundLateDelivery, RefundOther:
+		return true
+	}
+	return false
+}
+
+type RefundStatus string
+
+const (
+	RefundPending   RefundStatus = "Pending"
+	RefundSucceeded RefundStatus = "Succeeded"
+	RefundFailed    RefundStatus = "Failed"
+)
+
+const EventOrderRefunded = "OrderRefunded"
+
+// RefundItem is the refunded part of an order item, Amount is its price times Quantity.
+type RefundItem struct {
+	ProductID int64
+	Quantity  int32
+	Amount    int64
+}
+
+// Refund is a row of the refund ledger: money returned from the captured
+// payment of an order. A refund without items returns an amount not tied to
+// dishes, e.g. the rest of the order. Pending refunds count as refunded, so
+// concurrent refunds can not exceed the captured amount.
+type Refund struct {
+	ID        int64
+	OrderID   int64
+	PaymentID int64
+	Amount    int64
+	Reason    RefundReason
+	Comment   string
+	Actor     string
+	Status    RefundStatus
+	Items     []RefundItem
+	// ProviderRef is the id of the refund on the payment provider side.
+	ProviderRef   string
+	FailureReason string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (r *Refund) Succeed(providerRef string) error {
+	if r.Status != RefundPending {
+		return fmt.Errorf("%w: refund can not move from %s to %s", ErrInvalidTransition, r.Status, RefundSucceeded)
+	}
+	r.Status = RefundSucceeded
+	r.ProviderRef = providerRef
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *Refund) Fail(reason string) error {
+	if r.Status != RefundPending {
+		return fmt.Errorf("%w: refund can not move from %s to %s", ErrInvalidTransition, r.Status, RefundFailed)
+	}
+	r.Status = RefundFailed
+	r.FailureReason = reason
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// RefundLedger is all refunds of an order.
+type RefundLedger []Refund
+
+// Refunded returns the amount refunded or being refunded.
+func (l RefundLedger) Refunded() int64 {
+	var total int64
+	for _, refund := range l {
+		if refund.Status != RefundFailed {
+			total += refund.Amount
+		}
+	}
+	return total
+}
+
+// RefundedQuantity returns how many items of the product are refunded or being refunded.
+func (l RefundLedger) RefundedQuantity(productID int64) int32 {
+	var quantity int32
+	for _, refund := range l {
+		if refund.Status == RefundFailed {
+			continue
+		}
+		for _, item := range refund.Items {
+			if item.ProductID == productID {
+				quantity += item.Quantity
+			}
+		}
+	}
+	return quantity
+}
+
+type RefundRequest struct {
+	Reason  RefundReason
+	Comment string
+	Actor   string
+	// Items to refund, empty refunds everything not refunded yet.
+	Items []OrderItem
+}
+
+// NewRefund validates the request against the order, its payment and earlier
+// refunds and returns a pending refund. Only captured payments of orders that
+// can move into Refunded are refunded, and never more than was captured.
+func NewRefund(order *Order, payment *Payment, ledger RefundLedger, req RefundRequest) (*Refund, error) {
+	if !order.Status.CanTransitionTo(OrderRefunded) {
+		return nil, fmt.Errorf("%w: order is %s", ErrOrderNotRefundable, order.Status)
+	}
+	if payment.Status != PaymentCaptured {
+		return nil, fmt.Errorf("%w: payment is %s", ErrPaymentNotCaptured, payment.Status)
+	}
+
+	violations := &ValidationError{}
+	if !req.Reason.IsValid() {
+		violations.Add("reason", fmt.Sprintf("unknown reason code %q", req.Reason))
+	}
+
+	items := MergeItems(req.Items)
+	refundItems := make([]RefundItem, 0, len(items))
+	var amount int64
+	for i, item := range items {
+		field := fmt.Sprintf("items[%d]", i)
+		ordered := order.Item(item.ProductID)
+		if ordered == nil {
+			violations.Add(field+".product_id", fmt.Sprintf("product %d is not in the order", item.ProductID))
+			continue
+		}
+		left := ordered.Quantity - ledger.RefundedQuantity(item.ProductID)
+		if left <= 0 {
+			violations.Add(field+".product_id", fmt.Sprintf("product %d is refunded already", item.ProductID))
+			continue
+		}
+		if item.Quantity <= 0 || item.Quantity > left {
+			violations.Add(field+".quantity", fmt.Sprintf("must be between 1 and %d", left))
+			continue
+		}
+
+		refundItems = append(refundItems, RefundItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Amount:    ordered.Price * int64(item.Quantity),
+		})
+		amount += ordered.Price * int64(item.Quantity)
+	}
+
+	if err := violations.Err(); err != nil {
+		return nil, err
+	}
+
+	left := payment.Amount - ledger.Refunded()
+	if len(items) == 0 {
+		amount = left
+	}
+	if left <= 0 {
+		return nil, fmt.Errorf("%w: captured %d is refunded already", ErrRefundExceedsCaptured, payment.Amount)
+	}
+	if amount > left {
+		return nil, fmt.Errorf("%w: refund %d, left %d of captured %d",
+			ErrRefundExceedsCaptured, amount, left, payment.Amount)
+	}
+
+	now := time.Now()
+	return &Refund{
+		OrderID:   order.ID,
+		PaymentID: payment.ID,
+		Amount:    amount,
+		Reason:    req.Reason,
+		Comment:   req.Comment,
+		Actor:     req.Actor,
+		Status:    RefundPending,
+		Items:     refundItems,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// RefundEventFactory builds the outbox message of a completed refund.
+type RefundEventFactory func(refund *Refund) (*OutboxMessage, error)
+
+type RefundRepository interface {
+	// Create stores a pending refund. refunded is the ledger total the refund
+	// was validated against, ErrRefundConflict is returned if it has changed.
+	Create(ctx context.Context, refund *Refund, refunded int64) (int64, error)
+	// ListByOrderID returns the ledger of the order, oldest first.
+	ListByOrderID(ctx context.Context, orderID int64) (RefundLedger, error)
+	// Complete saves the outcome of a pending refund, status changes of its order
+	// and, if event is not nil, its outbox message in one transaction. Returns
+	// ErrRefundConflict if the refund is not pending anymore and
+	// ErrOrderStatusConflict like OrderRepository.UpdateStatus.
+	Complete(ctx context.Context, refund *Refund, event RefundEventFactory, changes ...StatusChange) error
+}
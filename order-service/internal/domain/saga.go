@@ -0,0 +1,213 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type SagaStep string
+
+const (
+	SagaReserveItems     SagaStep = "reserve_items"
+	SagaAuthorizePayment SagaStep = "authorize_payment"
+	SagaConfirmTicket    SagaStep = "confirm_ticket"
+)
+
+// sagaSteps run one by one. When a step fails, completed steps that hold
+// something are compensated: the payment is voided and the ticket cancelled.
+var sagaSteps = []SagaStep{SagaReserveItems, SagaAuthorizePayment, SagaConfirmTicket}
+
+var sagaCompensable = map[SagaStep]bool{
+	SagaAuthorizePayment: true,
+	SagaConfirmTicket:    true,
+}
+
+type SagaStatus string
+
+const (
+	SagaRunning      SagaStatus = "Running"
+	SagaCompensating SagaStatus = "Compensating"
+	SagaCompleted    SagaStatus = "Completed"
+	SagaFailed       SagaStatus = "Failed"
+)
+
+// Saga is the state of the order saga, one per order. Every command has a
+// deadline, a step without reply by then is treated as failed and
+// compensations without reply are sent again.
+type Saga struct {
+	OrderID int64
+	Status  SagaStatus
+	// Step waits for a reply while Running.
+	Step SagaStep
+	// Pending compensations wait for a reply while Compensating.
+	Pending       []SagaStep
+	FailureReason string
+	// Attempts counts rounds of compensation commands.
+	Attempts   int
+	DeadlineAt time.Time
+	// Version is bumped on every update for optimistic locking.
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SagaCommand asks a participant to run or compensate a step.
+type SagaCommand struct {
+	Step       SagaStep
+	Compensate bool
+}
+
+// SagaReply is the outcome of a SagaCommand reported by a participant.
+type SagaReply struct {
+	Step       SagaStep
+	Compensate bool
+	Success    bool
+	Reason     string
+}
+
+// NewSaga starts a saga with the first step.
+func NewSaga(orderID int64, now time.Time, timeout time.Duration) (*Saga, []SagaCommand) {
+	saga := &Saga{
+		OrderID:    orderID,
+		Status:     SagaRunning,
+		Step:       sagaSteps[0],
+		DeadlineAt: now.Add(timeout),
+		Version:    1,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	return saga, []SagaCommand{{Step: saga.Step}}
+}
+
+func (s *Saga) IsFinished() bool {
+	return s.Status == SagaCompleted || s.Status == SagaFailed
+}
+
+// Apply moves the saga on reply and returns the commands to send. Replies the
+// saga does not wait for, e.g. redelivered or late ones, fail with ErrSagaStaleReply.
+func (s *Saga) Apply(reply SagaReply, now time.Time, timeout time.Duration) ([]SagaCommand, error) {
+	switch {
+	case s.Status == SagaRunning && !reply.Compensate && reply.Step == s.Step:
+		s.UpdatedAt = now
+		if !reply.Success {
+			return s.compensate(s.completedSteps(false), reply.Reason, now, timeout), nil
+		}
+
+		i := slices.Index(sagaSteps, s.Step)
+		if i == len(sagaSteps)-1 {
+			s.Status = SagaCompleted
+			s.DeadlineAt = time.Time{}
+			return nil, nil
+		}
+		s.Step = sagaSteps[i+1]
+		s.DeadlineAt = now.Add(timeout)
+		return []SagaCommand{{Step: s.Step}}, nil
+
+	case s.Status == SagaCompensating && reply.Compensate && slices.Contains(s.Pending, reply.Step):
+		s.UpdatedAt = now
+		s.Pending = slices.DeleteFunc(s.Pending, func(step SagaStep) bool { return step == reply.Step })
+		if !reply.Success {
+			// Nothing else can be done automatically, e.g. the food is already cooked.
+			s.FailureReason += fmt.Sprintf("; compensation of %s failed: %s", reply.Step, reply.Reason)
+		}
+		if len(s.Pending) == 0 {
+			s.Status = SagaFailed
+			s.DeadlineAt = time.Time{}
+		}
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("%w: %s reply for %s saga", ErrSagaStaleReply, reply.Step, s.Status)
+	}
+}
+
+// Timeout handles a missed deadline: a running saga is aborted and
+// compensations are sent again until maxAttempts.
+func (s *Saga) Timeout(now time.Time, timeout time.Duration, maxAttempts int) []SagaCommand {
+	s.UpdatedAt = now
+
+	switch s.Status {
+	case SagaRunning:
+		return s.Abort(fmt.Sprintf("%s timed out", s.Step), now, timeout)
+
+	case SagaCompensating:
+		s.Attempts++
+		if s.Attempts >= maxAttempts {
+			s.FailureReason += fmt.Sprintf("; compensation of %v timed out", s.Pending)
+			s.Status = SagaFailed
+			s.DeadlineAt = time.Time{}
+			return nil
+		}
+		s.DeadlineAt = now.Add(timeout)
+		return compensations(s.Pending)
+	}
+
+	return nil
+}
+
+// Abort stops a running saga, e.g. because the order was cancelled meanwhile.
+// The current step may have been done already, so it is compensated too.
+func (s *Saga) Abort(reason string, now time.Time, timeout time.Duration) []SagaCommand {
+	if s.Status != SagaRunning {
+		return nil
+	}
+
+	s.UpdatedAt = now
+	return s.compensate(s.completedSteps(true), reason, now, timeout)
+}
+
+// completedSteps returns compensable steps before the current one, including
+// it if withCurrent is set.
+func (s *Saga) completedSteps(withCurrent bool) []SagaStep {
+	end := slices.Index(sagaSteps, s.Step)
+	if withCurrent {
+		end++
+	}
+
+	steps := make([]SagaStep, 0, end)
+	for i := end - 1; i >= 0; i-- {
+		if sagaCompensable[sagaSteps[i]] {
+			steps = append(steps, sagaSteps[i])
+		}
+	}
+	return steps
+}
+
+func (s *Saga) compensate(steps []SagaStep, reason string, now time.Time, timeout time.Duration) []SagaCommand {
+	s.FailureReason = reason
+	s.Pending = steps
+	s.Attempts = 0
+	if len(steps) == 0 {
+		s.Status = SagaFailed
+		s.DeadlineAt = time.Time{}
+		return nil
+	}
+
+	s.Status = SagaCompensating
+	s.DeadlineAt = now.Add(timeout)
+	return compensations(steps)
+}
+
+func compensations(steps []SagaStep) []SagaCommand {
+	commands := make([]SagaCommand, 0, len(steps))
+	for _, step := range steps {
+		commands = append(commands, SagaCommand{Step: step, Compensate: true})
+	}
+	return commands
+}
+
+type SagaRepository interface {
+	// Create stores a new saga and its commands in one transaction. Returns
+	// ErrSagaExists if the order already has a saga.
+	Create(ctx context.Context, saga *Saga, commands []*OutboxMessage) error
+	// Get returns ErrSagaNotFound if the order has no saga.
+	Get(ctx context.Context, orderID int64) (*Saga, error)
+	// Update saves the saga, its commands, status changes of its order and
+	// their events in one transaction and bumps its version. Returns ErrSagaConflict if the saga
+	// was updated concurrently and ErrOrderStatusConflict like UpdateStatus.
+	Update(ctx context.Context, saga *Saga, messages []*OutboxMessage, changes ...StatusChange) error
+	// Due returns order ids of unfinished sagas with deadline not after t.
+	Due(ctx context.Context, t time.Time, limit int) ([]int64, error)
+}
@@ -0,0 +1,356 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+var (
+	sagaNow     = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	sagaTimeout = 30 * time.Second
+	// sagaNextDeadline is the deadline of a command sent at sagaNow.
+	sagaNextDeadline = sagaNow.Add(sagaTimeout)
+)
+
+func runningSaga(step SagaStep) *Saga {
+	return &Saga{
+		OrderID:    1,
+		Status:     SagaRunning,
+		Step:       step,
+		DeadlineAt: sagaNow,
+		Version:    1,
+	}
+}
+
+func compensatingSaga(attempts int, pending ...SagaStep) *Saga {
+	return &Saga{
+		OrderID:       1,
+		Status:        SagaCompensating,
+		Step:          SagaConfirmTicket,
+		Pending:       pending,
+		FailureReason: "kitchen closed",
+		Attempts:      attempts,
+		DeadlineAt:    sagaNow,
+		Version:       1,
+	}
+}
+
+func finishedSaga(status SagaStatus) *Saga {
+	return &Saga{OrderID: 1, Status: status, Step: SagaConfirmTicket, Version: 1}
+}
+
+// sagaWant is the expected saga state after a transition.
+type sagaWant struct {
+	status   SagaStatus
+	step     SagaStep
+	pending  []SagaStep
+	reason   string
+	attempts int
+	deadline time.Time
+}
+
+func checkSaga(t *testing.T, saga *Saga, want sagaWant) {
+	t.Helper()
+
+	if saga.Status != want.status {
+		t.Errorf("Status = %s, want %s", saga.Status, want.status)
+	}
+	if saga.Step != want.step {
+		t.Errorf("Step = %s, want %s", saga.Step, want.step)
+	}
+	if !slices.Equal(saga.Pending, want.pending) {
+		t.Errorf("Pending = %v, want %v", saga.Pending, want.pending)
+	}
+	if saga.FailureReason != want.reason {
+		t.Errorf("FailureReason = %q, want %q", saga.FailureReason, want.reason)
+	}
+	if saga.Attempts != want.attempts {
+		t.Errorf("Attempts = %d, want %d", saga.Attempts, want.attempts)
+	}
+
+	if !saga.DeadlineAt.Equal(want.deadline) {
+		t.Errorf("DeadlineAt = %v, want %v", saga.DeadlineAt, want.deadline)
+	}
+}
+
+func TestNewSaga(t *testing.T) {
+	saga, commands := NewSaga(1, sagaNow, sagaTimeout)
+
+	checkSaga(t, saga, sagaWant{status: SagaRunning, step: SagaReserveItems, deadline: sagaNextDeadline})
+	if want := []SagaCommand{{Step: SagaReserveItems}}; !slices.Equal(commands, want) {
+		t.Errorf("commands = %v, want %v", commands, want)
+	}
+}
+
+func TestSagaApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		saga     *Saga
+		reply    SagaReply
+		commands []SagaCommand
+		want     sagaWant
+	}{
+		{
+			name:     "step succeeded",
+			saga:     runningSaga(SagaReserveItems),
+			reply:    SagaReply{Step: SagaReserveItems, Success: true},
+			commands: []SagaCommand{{Step: SagaAuthorizePayment}},
+			want:     sagaWant{status: SagaRunning, step: SagaAuthorizePayment, deadline: sagaNextDeadline},
+		},
+		{
+			name:  "last step succeeded",
+			saga:  runningSaga(SagaConfirmTicket),
+			reply: SagaReply{Step: SagaConfirmTicket, Success: true},
+			want:  sagaWant{status: SagaCompleted, step: SagaConfirmTicket},
+		},
+		{
+			name:  "first step failed",
+			saga:  runningSaga(SagaReserveItems),
+			reply: SagaReply{Step: SagaReserveItems, Reason: "out of stock"},
+			want:  sagaWant{status: SagaFailed, step: SagaReserveItems, pending: []SagaStep{}, reason: "out of stock"},
+		},
+		{
+			name:  "step failed after steps without compensation",
+			saga:  runningSaga(SagaAuthorizePayment),
+			reply: SagaReply{Step: SagaAuthorizePayment, Reason: "card declined"},
+			want: sagaWant{status: SagaFailed, step: SagaAuthorizePayment, pending: []SagaStep{},
+				reason: "card declined"},
+		},
+		{
+			name:     "step failed after compensable step",
+			saga:     runningSaga(SagaConfirmTicket),
+			reply:    SagaReply{Step: SagaConfirmTicket, Reason: "kitchen closed"},
+			commands: []SagaCommand{{Step: SagaAuthorizePayment, Compensate: true}},
+			want: sagaWant{status: SagaCompensating, step: SagaConfirmTicket,
+				pending: []SagaStep{SagaAuthorizePayment}, reason: "kitchen closed", deadline: sagaNextDeadline},
+		},
+		{
+			name:  "compensation succeeded",
+			saga:  compensatingSaga(0, SagaConfirmTicket, SagaAuthorizePayment),
+			reply: SagaReply{Step: SagaConfirmTicket, Compensate: true, Success: true},
+			want: sagaWant{status: SagaCompensating, step: SagaConfirmTicket,
+				pending: []SagaStep{SagaAuthorizePayment}, reason: "kitchen closed", deadline: sagaNow},
+		},
+		{
+			name:  "last compensation succeeded",
+			saga:  compensatingSaga(1, SagaAuthorizePayment),
+			reply: SagaReply{Step: SagaAuthorizePayment, Compensate: true, Success: true},
+			want: sagaWant{status: SagaFailed, step: SagaConfirmTicket, pending: []SagaStep{},
+				reason: "kitchen closed", attempts: 1},
+		},
+		{
+			name:  "compensation failed",
+			saga:  compensatingSaga(0, SagaConfirmTicket),
+			reply: SagaReply{Step: SagaConfirmTicket, Compensate: true, Reason: "already cooking"},
+			want: sagaWant{status: SagaFailed, step: SagaConfirmTicket, pending: []SagaStep{},
+				reason: "kitchen closed; compensation of confirm_ticket failed: already cooking"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands, err := tt.saga.Apply(tt.reply, sagaNow, sagaTimeout)
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if !slices.Equal(commands, tt.commands) {
+				t.Errorf("commands = %v, want %v", commands, tt.commands)
+			}
+			checkSaga(t, tt.saga, tt.want)
+			if !tt.saga.UpdatedAt.Equal(sagaNow) {
+				t.Errorf("UpdatedAt = %v, want %v", tt.saga.UpdatedAt, sagaNow)
+			}
+		})
+	}
+}
+
+func TestSagaApplyStaleReply(t *testing.T) {
+	tests := []struct {
+		name  string
+		saga  *Saga
+		reply SagaReply
+	}{
+		{
+			name:  "reply of a previous step",
+			saga:  runningSaga(SagaAuthorizePayment),
+			reply: SagaReply{Step: SagaReserveItems, Success: true},
+		},
+		{
+			name:  "reply of a later step",
+			saga:  runningSaga(SagaAuthorizePayment),
+			reply: SagaReply{Step: SagaConfirmTicket, Success: true},
+		},
+		{
+			name:  "compensation reply while running",
+			saga:  runningSaga(SagaAuthorizePayment),
+			reply: SagaReply{Step: SagaAuthorizePayment, Compensate: true, Success: true},
+		},
+		{
+			name:  "step reply while compensating",
+			saga:  compensatingSaga(0, SagaAuthorizePayment),
+			reply: SagaReply{Step: SagaConfirmTicket, Success: true},
+		},
+		{
+			name:  "duplicated compensation reply",
+			saga:  compensatingSaga(0, SagaAuthorizePayment),
+			reply: SagaReply{Step: SagaConfirmTicket, Compensate: true, Success: true},
+		},
+		{
+			name:  "reply after completion",
+			saga:  finishedSaga(SagaCompleted),
+			reply: SagaReply{Step: SagaConfirmTicket, Success: true},
+		},
+		{
+			name:  "reply after failure",
+			saga:  finishedSaga(SagaFailed),
+			reply: SagaReply{Step: SagaAuthorizePayment, Compensate: true, Success: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := *tt.saga
+			before.Pending = slices.Clone(tt.saga.Pending)
+
+			commands, err := tt.saga.Apply(tt.reply, sagaNow, sagaTimeout)
+			if !errors.Is(err, ErrSagaStaleReply) {
+				t.Fatalf("Apply() error = %v, want %v", err, ErrSagaStaleReply)
+			}
+			if commands != nil {
+				t.Errorf("commands = %v, want none", commands)
+			}
+			if !reflect.DeepEqual(*tt.saga, before) {
+				t.Errorf("saga = %+v, want unchanged %+v", *tt.saga, before)
+			}
+		})
+	}
+}
+
+func TestSagaApplyDuplicatedReply(t *testing.T) {
+	saga, _ := NewSaga(1, sagaNow, sagaTimeout)
+	reply := SagaReply{Step: SagaReserveItems, Success: true}
+
+	if _, err := saga.Apply(reply, sagaNow, sagaTimeout); err != nil {
+		t.Fatalf("first Apply() error = %v", err)
+	}
+	if _, err := saga.Apply(reply, sagaNow, sagaTimeout); !errors.Is(err, ErrSagaStaleReply) {
+		t.Fatalf("second Apply() error = %v, want %v", err, ErrSagaStaleReply)
+	}
+	checkSaga(t, saga, sagaWant{status: SagaRunning, step: SagaAuthorizePayment, deadline: sagaNextDeadline})
+}
+
+func TestSagaTimeout(t *testing.T) {
+	const maxAttempts = 3
+
+	tests := []struct {
+		name     string
+		saga     *Saga
+		commands []SagaCommand
+		want     sagaWant
+	}{
+		{
+			name: "first step timed out",
+			saga: runningSaga(SagaReserveItems),
+			want: sagaWant{status: SagaFailed, step: SagaReserveItems, pending: []SagaStep{},
+				reason: "reserve_items timed out"},
+		},
+		{
+			name: "compensable step timed out",
+			saga: runningSaga(SagaConfirmTicket),
+			commands: []SagaCommand{
+				{Step: SagaConfirmTicket, Compensate: true},
+				{Step: SagaAuthorizePayment, Compensate: true},
+			},
+			want: sagaWant{status: SagaCompensating, step: SagaConfirmTicket,
+				pending: []SagaStep{SagaConfirmTicket, SagaAuthorizePayment},
+				reason:  "confirm_ticket timed out", deadline: sagaNextDeadline},
+		},
+		{
+			name:     "compensation timed out",
+			saga:     compensatingSaga(0, SagaConfirmTicket, SagaAuthorizePayment),
+			commands: []SagaCommand{{Step: SagaConfirmTicket, Compensate: true}, {Step: SagaAuthorizePayment, Compensate: true}},
+			want: sagaWant{status: SagaCompensating, step: SagaConfirmTicket,
+				pending: []SagaStep{SagaConfirmTicket, SagaAuthorizePayment},
+				reason:  "kitchen closed", attempts: 1, deadline: sagaNextDeadline},
+		},
+		{
+			name:     "compensation timed out again",
+			saga:     compensatingSaga(maxAttempts-2, SagaAuthorizePayment),
+			commands: []SagaCommand{{Step: SagaAuthorizePayment, Compensate: true}},
+			want: sagaWant{status: SagaCompensating, step: SagaConfirmTicket,
+				pending: []SagaStep{SagaAuthorizePayment}, reason: "kitchen closed",
+				attempts: maxAttempts - 1, deadline: sagaNextDeadline},
+		},
+		{
+			name: "compensation attempts exhausted",
+			saga: compensatingSaga(maxAttempts-1, SagaAuthorizePayment),
+			want: sagaWant{status: SagaFailed, step: SagaConfirmTicket,
+				pending: []SagaStep{SagaAuthorizePayment},
+				reason:  "kitchen closed; compensation of [authorize_payment] timed out", attempts: maxAttempts},
+		},
+		{
+			name: "completed saga",
+			saga: finishedSaga(SagaCompleted),
+			want: sagaWant{status: SagaCompleted, step: SagaConfirmTicket},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := tt.saga.Timeout(sagaNow, sagaTimeout, maxAttempts)
+			if !slices.Equal(commands, tt.commands) {
+				t.Errorf("commands = %v, want %v", commands, tt.commands)
+			}
+			checkSaga(t, tt.saga, tt.want)
+		})
+	}
+}
+
+func TestSagaAbort(t *testing.T) {
+	const reason = "order cancelled"
+
+	tests := []struct {
+		name     string
+		saga     *Saga
+		commands []SagaCommand
+		want     sagaWant
+	}{
+		{
+			name: "nothing to compensate",
+			saga: runningSaga(SagaReserveItems),
+			want: sagaWant{status: SagaFailed, step: SagaReserveItems, pending: []SagaStep{}, reason: reason},
+		},
+		{
+			name:     "current step compensated",
+			saga:     runningSaga(SagaAuthorizePayment),
+			commands: []SagaCommand{{Step: SagaAuthorizePayment, Compensate: true}},
+			want: sagaWant{status: SagaCompensating, step: SagaAuthorizePayment,
+				pending: []SagaStep{SagaAuthorizePayment}, reason: reason, deadline: sagaNextDeadline},
+		},
+		{
+			name: "already compensating",
+			saga: compensatingSaga(1, SagaAuthorizePayment),
+			want: sagaWant{status: SagaCompensating, step: SagaConfirmTicket,
+				pending: []SagaStep{SagaAuthorizePayment}, reason: "kitchen closed", attempts: 1,
+				deadline: sagaNow},
+		},
+		{
+			name: "completed saga",
+			saga: finishedSaga(SagaCompleted),
+			want: sagaWant{status: SagaCompleted, step: SagaConfirmTicket},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := tt.saga.Abort(reason, sagaNow, sagaTimeout)
+			if !slices.Equal(commands, tt.commands) {
+				t.Errorf("commands = %v, want %v", commands, tt.commands)
+			}
+			checkSaga(t, tt.saga, tt.want)
+		})
+	}
+}
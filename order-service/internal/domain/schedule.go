@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// MaxScheduleAhead limits how far in the future an order can be scheduled.
+	MaxScheduleAhead = 7 * 24 * time.Hour
+	// DeliveryLeadTime is reserved for courier pickup and the trip to the customer.
+	DeliveryLeadTime = 15 * time.Minute
+)
+
+// ReleaseTime returns when a scheduled order must be handed over to the kitchen
+// so that it is delivered at scheduledFor. It reports *ValidationError if
+// scheduledFor is too close or too far from now.
+func ReleaseTime(scheduledFor, now time.Time, prepTime time.Duration) (time.Time, error) {
+	lead := prepTime + DeliveryLeadTime
+	releaseAt := scheduledFor.Add(-lead)
+
+	switch {
+	case releaseAt.Before(now):
+		return time.Time{}, NewValidationError("scheduled_for",
+			fmt.Sprintf("must be at least %s from now", lead))
+	case scheduledFor.Sub(now) > MaxScheduleAhead:
+		return time.Time{}, NewValidationError("scheduled_for",
+			fmt.Sprintf("must be at most %s from now", MaxScheduleAhead))
+	}
+
+	return releaseAt, nil
+}
+
+// Schedule defers a new order. It stays in OrderScheduled and the scheduler
+// moves it into OrderCreated at releaseAt, see ReleaseTime.
+func (o *Order) Schedule(scheduledFor, releaseAt time.Time) {
+	o.Status = OrderScheduled
+	o.ScheduledFor = scheduledFor
+	o.ReleaseAt = releaseAt
+}
+
+func (o *Order) IsScheduled() bool {
+	return !o.ScheduledFor.IsZero()
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type OrderStatus string
+
+const (
+	OrderScheduled      OrderStatus = "Scheduled"
+	OrderCreated        OrderStatus = "Created"
+	OrperPaid           OrderStatus = "Paid"
+	OrderAccepted       OrderStatus = "Accepted"
+	OrderPreparing      OrderStatus = "Preparing"
+	OrderReadyForPickup OrderStatus = "ReadyForPickup"
+	OrderPickedUp       OrderStatus = "PickedUp"
+	OrderDelivered      OrderStatus = "Delivered"
+	OrderCancelled      OrderStatus = "Cancelled"
+	OrderRejected       OrderStatus = "Rejected"
+	OrderRefunded       OrderStatus = "Refunded"
+)
+
+// Who changed the order status.
+const (
+	ActorCustomer   = "customer"
+	ActorRestaurant = "restaurant"
+	ActorCourier    = "courier"
+	ActorSupport    = "support"
+	ActorSystem     = "system"
+)
+
+// transitions is the order state machine:
+//
+//	Created -> Paid -> Accepted -> Preparing -> ReadyForPickup -> PickedUp -> Delivered
+//
+// Scheduled orders wait in Scheduled until the scheduler releases them into Created.
+// Orders can be cancelled by the customer until the restaurant accepts them and
+// rejected by the restaurant until cooking starts. Their payment is only
+// authorized and gets voided. The payment is captured once the food is ready, so
+// from ReadyForPickup on the order may end up in Refunded, also before delivery.
+var transitions = map[OrderStatus][]OrderStatus{
+	OrderScheduled:      {OrderCreated, OrderCancelled},
+	OrderCreated:        {OrperPaid, OrderAccepted, OrderCancelled, OrderRejected},
+	OrperPaid:           {OrderAccepted, OrderCancelled, OrderRejected},
+	OrderAccepted:       {OrderPreparing, OrderRejected},
+	OrderPreparing:      {OrderReadyForPickup},
+	OrderReadyForPickup: {OrderPickedUp, OrderRefunded},
+	OrderPickedUp:       {OrderDelivered, OrderRefunded},
+	OrderDelivered:      {OrderRefunded},
+	OrderCancelled:      {},
+	OrderRejected:       {},
+	OrderRefunded:       {},
+}
+
+func (s OrderStatus) IsValid() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+// IsFinal reports whether no further transitions are possible.
+func (s OrderStatus) IsFinal() bool {
+	return len(transitions[s]) == 0
+}
+
+// IsTerminal reports whether the order was delivered or closed. Only a refund
+// of a delivered order may follow, nothing changes for the customer anymore.
+func (s OrderStatus) IsTerminal() bool {
+	switch s {
+	case OrderDelivered, OrderCancelled, OrderRejected, OrderRefunded:
+		return true
+	}
+	return false
+}
+
+func (s OrderStatus) CanTransitionTo(to OrderStatus) bool {
+	for _, next := range transitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionError is returned when the state machine forbids a status change.
+// It matches ErrInvalidTransition with errors.Is.
+type TransitionError struct {
+	From OrderStatus
+	To   OrderStatus
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("order can not move from %s to %s", e.From, e.To)
+}
+
+func (e *TransitionError) Unwrap() error {
+	return ErrInvalidTransition
+}
+
+// Transition moves the order into status `to` if the state machine allows it.
+func (o *Order) Transition(to OrderStatus) error {
+	if !to.IsValid() {
+		return fmt.Errorf("%w: %q", ErrUnknownStatus, to)
+	}
+	if !o.Status.CanTransitionTo(to) {
+		return &TransitionError{From: o.Status, To: to}
+	}
+
+	o.Status = to
+	o.UpdatedAt = time.Now()
+	return nil
+}
+
+// StatusChange is a single record of order status history.
+type StatusChange struct {
+	OrderID   int64
+	From      OrderStatus // empty for the initial status
+	To        OrderStatus
+	Actor     string
+	Reason    string
+	ChangedAt time.Time
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+const (
+	MaxOrderItems   = 50
+	MaxItemQuantity = 99
+)
+
+// ValidateOrder checks a new order and reports all violations at once as
+// *ValidationError. Duplicate products are allowed, MergeItems sums them up,
+// and the limits are checked against the merged items.
+func ValidateOrder(userID, restaurantID int64, items []OrderItem, address DeliveryAddress) error {
+	violations := &ValidationError{}
+
+	if userID <= 0 {
+		violations.Add("user_id", "must be positive")
+	}
+	if restaurantID <= 0 {
+		violations.Add("restaurant_id", "must be positive")
+	}
+
+	if len(items) == 0 {
+		violations.AddErr("items", ErrEmptyItems)
+	}
+
+	firstIndex := make(map[int64]int, len(items))
+	quantities := make(map[int64]int64, len(items))
+	for i, item := range items {
+		if item.ProductID <= 0 {
+			violations.Add(fmt.Sprintf("items[%d].product_id", i), "must be positive")
+		}
+		if item.Quantity <= 0 {
+			violations.Add(fmt.Sprintf("items[%d].quantity", i), "must be positive")
+			continue
+		}
+
+		if _, ok := firstIndex[item.ProductID]; !ok {
+			firstIndex[item.ProductID] = i
+		}
+		quantities[item.ProductID] += int64(item.Quantity)
+	}
+
+	if len(firstIndex) > MaxOrderItems {
+		violations.AddErr("items", fmt.Errorf("%w: at most %d different products allowed", ErrTooManyItems, MaxOrderItems))
+	}
+	for _, productID := range slices.Sorted(maps.Keys(quantities)) {
+		if quantities[productID] > MaxItemQuantity {
+			violations.Add(fmt.Sprintf("items[%d].quantity", firstIndex[productID]),
+				fmt.Sprintf("total quantity of product %d must be at most %d", productID, MaxItemQuantity))
+		}
+	}
+
+	address.validate("address", violations)
+
+	return violations.Err()
+}
+
+// MergeItems sums up quantities of duplicate products keeping the order of first occurrence.
+func MergeItems(items []OrderItem) []OrderItem {
+	merged := make([]OrderItem, 0, len(items))
+	index := make(map[int64]int, len(items))
+	for _, item := range items {
+		if i, ok := index[item.ProductID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.ProductID] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged
+}
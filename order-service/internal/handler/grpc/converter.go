@@ -0,0 +1,137 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/order-service/internal/usecase"
+	pb "github.com/Wuchinator/food-delivery/order-service/pkg/order_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toPbOrder(order *domain.Order) *pb.Order {
+	items := make([]*pb.OrderItemInfo, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, &pb.OrderItemInfo{
+			ProductId: item.ProductID,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+		})
+	}
+
+	pbOrder := &pb.Order{
+		Id:           order.ID,
+		UserId:       order.UserID,
+		RestaurantId: order.RestaurantID,
+		Items:        items,
+		Total:        order.Total,
+		Status:       string(order.Status),
+		CreatedAt:    timestamppb.New(order.CreatedAt),
+		UpdatedAt:    timestamppb.New(order.UpdatedAt),
+
+		DeliveryAddress: order.DeliveryAddress.String(),
+		Address:         toPbAddress(order.DeliveryAddress),
+	}
+	if order.IsScheduled() {
+		pbOrder.ScheduledFor = timestamppb.New(order.ScheduledFor)
+	}
+
+	return pbOrder
+}
+
+func toPbAddress(address domain.DeliveryAddress) *pb.DeliveryAddress {
+	return &pb.DeliveryAddress{
+		Street:    address.Street,
+		Apartment: address.Apartment,
+		City:      address.City,
+		Lat:       address.Lat,
+		Lng:       address.Lng,
+		Comment:   address.Comment,
+	}
+}
+
+func fromPbAddress(address *pb.DeliveryAddress) domain.DeliveryAddress {
+	return domain.DeliveryAddress{
+		Street:    address.GetStreet(),
+		Apartment: address.GetApartment(),
+		City:      address.GetCity(),
+		Lat:       address.GetLat(),
+		Lng:       address.GetLng(),
+		Comment:   address.GetComment(),
+	}
+}
+
+func toPbStatusChange(change domain.StatusChange) *pb.StatusChange {
+	return &pb.StatusChange{
+		FromStatus: string(change.From),
+		ToStatus:   string(change.To),
+		Actor:      change.Actor,
+		Reason:     change.Reason,
+		ChangedAt:  timestamppb.New(change.ChangedAt),
+	}
+}
+
+func toPbOrderUpdate(update usecase.OrderUpdate) *pb.OrderUpdate {
+	result := &pb.OrderUpdate{
+		OrderId: update.Change.OrderID,
+		Version: update.Version,
+		Change:  toPbStatusChange(update.Change),
+	}
+	if !update.ETA.IsZero() {
+		result.Eta = timestamppb.New(update.ETA)
+	}
+	return result
+}
+
+func toPbRefund(refund *domain.Refund) *pb.Refund {
+	items := make([]*pb.RefundItem, 0, len(refund.Items))
+	for _, item := range refund.Items {
+		items = append(items, &pb.RefundItem{
+			ProductId: item.ProductID,
+			Quantity:  item.Quantity,
+			Amount:    item.Amount,
+		})
+	}
+
+	return &pb.Refund{
+		Id:        refund.ID,
+		OrderId:   refund.OrderID,
+		Amount:    refund.Amount,
+		Reason:    string(refund.Reason),
+		Comment:   refund.Comment,
+		Status:    string(refund.Status),
+		Items:     items,
+		CreatedAt: timestamppb.New(refund.CreatedAt),
+	}
+}
+
+// Page token is an opaque base64 of the last order id seen by the client.
+func encodePageToken(afterID int64) string {
+	if afterID == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(afterID, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	afterID, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if afterID <= 0 {
+		return 0, errors.New("page token must point to positive id")
+	}
+
+	return afterID, nil
+}
@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/grpcerr"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// errorCodes maps domain errors to gRPC codes, first match wins. Field, if set,
+// is reported as a BadRequest field violation.
+var errorCodes = []grpcerr.Mapping{
+	{Err: domain.ErrOrderNotFound, Code: codes.NotFound},
+	{Err: domain.ErrRestaurantNotFound, Code: codes.NotFound},
+	{Err: domain.ErrEmptyItems, Code: codes.InvalidArgument, Field: "items"},
+	{Err: domain.ErrProductUnavailable, Code: codes.InvalidArgument, Field: "items"},
+	{Err: domain.ErrUnknownStatus, Code: codes.InvalidArgument, Field: "status"},
+	{Err: domain.ErrInvalidArgument, Code: codes.InvalidArgument},
+	{Err: domain.ErrOrderNotCancellable, Code: codes.FailedPrecondition},
+	{Err: domain.ErrInvalidTransition, Code: codes.FailedPrecondition},
+	{Err: domain.ErrOrderStatusConflict, Code: codes.FailedPrecondition},
+	{Err: domain.ErrIdempotencyKeyReuse, Code: codes.FailedPrecondition},
+	{Err: domain.ErrAddressNotServed, Code: codes.FailedPrecondition},
+	{Err: domain.ErrRestaurantClosed, Code: codes.FailedPrecondition},
+	{Err: domain.ErrOrderNotRefundable, Code: codes.FailedPrecondition},
+	{Err: domain.ErrPaymentNotCaptured, Code: codes.FailedPrecondition},
+	{Err: domain.ErrRefundExceedsCaptured, Code: codes.FailedPrecondition},
+	{Err: domain.ErrRefundConflict, Code: codes.Aborted},
+}
+
+// ErrorUnaryInterceptor converts errors returned by handlers into gRPC statuses.
+func ErrorUnaryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return grpcerr.UnaryInterceptor(errorCodes, logger)
+}
+
+// ErrorStreamInterceptor is ErrorUnaryInterceptor for streaming handlers.
+func ErrorStreamInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return grpcerr.StreamInterceptor(errorCodes, logger)
+}
@@ -3,22 +3,47 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
 	"github.com/Wuchinator/food-delivery/order-service/internal/usecase"
 	pb "github.com/Wuchinator/food-delivery/order-service/pkg/order_v1"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
 )
 
 type Server struct {
 	pb.UnimplementedOrderServiceServer
-	usecase *usecase.CreateOrderUseCase
-	logger  *zap.Logger
+	usecase       *usecase.CreateOrderUseCase
+	cancelUsecase *usecase.CancelOrderUseCase
+	getUsecase    *usecase.GetOrderUseCase
+	listUsecase   *usecase.ListOrdersUseCase
+	historyUC     *usecase.GetOrderHistoryUseCase
+	refundUC      *usecase.RefundOrderUseCase
+	watchUC       *usecase.WatchOrderUseCase
+	logger        *zap.Logger
 }
 
-func NewServer(uc *usecase.CreateOrderUseCase, logger *zap.Logger) *Server {
+type UseCases struct {
+	CreateOrder *usecase.CreateOrderUseCase
+	CancelOrder *usecase.CancelOrderUseCase
+	GetOrder    *usecase.GetOrderUseCase
+	ListOrders  *usecase.ListOrdersUseCase
+	History     *usecase.GetOrderHistoryUseCase
+	RefundOrder *usecase.RefundOrderUseCase
+	WatchOrder  *usecase.WatchOrderUseCase
+}
+
+func NewServer(uc UseCases, logger *zap.Logger) *Server {
 	return &Server{
-		usecase: uc,
-		logger:  logger,
+		usecase:       uc.CreateOrder,
+		cancelUsecase: uc.CancelOrder,
+		getUsecase:    uc.GetOrder,
+		listUsecase:   uc.ListOrders,
+		historyUC:     uc.History,
+		refundUC:      uc.RefundOrder,
+		watchUC:       uc.WatchOrder,
+		logger:        logger,
 	}
 }
 
@@ -34,21 +59,231 @@ func (s *Server) CreateOrder(ctx context.Context,
 	}
 
 	input := usecase.CreateOrderInput{
-		UserID:       req.UserId,
-		RestaurantID: req.RestaurantId,
-		Items:        inputItems,
-		Address:      req.DeliveryAddress,
+		UserID:         req.UserId,
+		RestaurantID:   req.RestaurantId,
+		Items:          inputItems,
+		Address:        deliveryAddress(req),
+		IdempotencyKey: idempotencyKey(ctx, req),
+	}
+	if req.ScheduledFor != nil {
+		input.ScheduledFor = req.ScheduledFor.AsTime()
 	}
 
-	id, err := s.usecase.Exec(ctx, input)
+	if len(input.IdempotencyKey) > maxIdempotencyKeyLen {
+		return nil, domain.NewValidationError("idempotency_key",
+			fmt.Sprintf("must be at most %d characters", maxIdempotencyKeyLen))
+	}
+
+	output, err := s.usecase.Exec(ctx, input)
 	if err != nil {
 		s.logger.Error("Failed to exec order usecase", zap.Error(err))
-		return nil, fmt.Errorf("Failed to exec order usecase %w", err) // REFACTOR ON CODES GRPC FOR VEST PRACTICE
+		return nil, err
 	}
 
-	s.logger.Info("Created order response", zap.Int64("Id", id))
+	s.logger.Info("Created order response", zap.Int64("Id", output.OrderID))
 	return &pb.CreateOrderResponse{
-		OrderId: id,
-		Status:  "Created", // hardcode.
+		OrderId: output.OrderID,
+		Status:  string(output.Status),
+	}, nil
+}
+
+// deliveryAddress falls back to the deprecated single line address
+// in "street, city" form.
+func deliveryAddress(req *pb.CreateOrderRequest) domain.DeliveryAddress {
+	if req.Address != nil {
+		return fromPbAddress(req.Address)
+	}
+
+	line := req.GetDeliveryAddress()
+	if i := strings.LastIndex(line, ","); i >= 0 {
+		return domain.DeliveryAddress{Street: line[:i], City: line[i+1:]}
+	}
+	return domain.DeliveryAddress{Street: line}
+}
+
+const (
+	idempotencyKeyHeader = "idempotency-key"
+	maxIdempotencyKeyLen = 128
+)
+
+// idempotencyKey takes the key from request body or falls back to gRPC metadata.
+func idempotencyKey(ctx context.Context, req *pb.CreateOrderRequest) string {
+	if req.IdempotencyKey != "" {
+		return req.IdempotencyKey
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(idempotencyKeyHeader); len(values) > 0 {
+			return values[0]
+		}
+	}
+
+	return ""
+}
+
+func (s *Server) CancelOrder(ctx context.Context,
+	req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
+
+	if req.OrderId <= 0 {
+		return nil, domain.NewValidationError("order_id", "must be positive")
+	}
+
+	err := s.cancelUsecase.Exec(ctx, usecase.CancelOrderInput{
+		OrderID: req.OrderId,
+		Actor:   domain.ActorCustomer,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		s.logger.Error("Failed to exec cancel order usecase", zap.Int64("order_id", req.OrderId), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("Cancelled order response", zap.Int64("Id", req.OrderId))
+	return &pb.CancelOrderResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *Server) GetOrder(ctx context.Context,
+	req *pb.GetOrderRequest) (*pb.GetOrderResponse, error) {
+
+	if req.OrderId <= 0 {
+		return nil, domain.NewValidationError("order_id", "must be positive")
+	}
+
+	order, err := s.getUsecase.Exec(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetOrderResponse{
+		Order: toPbOrder(order),
+	}, nil
+}
+
+func (s *Server) ListOrders(ctx context.Context,
+	req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+
+	violations := &domain.ValidationError{}
+	if req.PageSize < 0 {
+		violations.Add("page_size", "can not be negative")
+	}
+
+	afterID, err := decodePageToken(req.PageToken)
+	if err != nil {
+		violations.Add("page_token", "invalid page token")
+	}
+
+	if st := domain.OrderStatus(req.GetStatus()); st != "" && !st.IsValid() {
+		violations.Add("status", domain.ErrUnknownStatus.Error())
+	}
+
+	if err := violations.Err(); err != nil {
+		return nil, err
+	}
+
+	input := usecase.ListOrdersInput{
+		UserID:       req.GetUserId(),
+		RestaurantID: req.GetRestaurantId(),
+		Status:       domain.OrderStatus(req.GetStatus()),
+		PageSize:     int(req.PageSize),
+		AfterID:      afterID,
+	}
+	if req.CreatedFrom != nil {
+		input.CreatedFrom = req.CreatedFrom.AsTime()
+	}
+	if req.CreatedTo != nil {
+		input.CreatedTo = req.CreatedTo.AsTime()
+	}
+
+	output, err := s.listUsecase.Exec(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*pb.Order, 0, len(output.Orders))
+	for i := range output.Orders {
+		orders = append(orders, toPbOrder(&output.Orders[i]))
+	}
+
+	return &pb.ListOrdersResponse{
+		Orders:        orders,
+		NextPageToken: encodePageToken(output.NextAfterID),
+	}, nil
+}
+
+func (s *Server) GetOrderHistory(ctx context.Context,
+	req *pb.GetOrderHistoryRequest) (*pb.GetOrderHistoryResponse, error) {
+
+	if req.OrderId <= 0 {
+		return nil, domain.NewValidationError("order_id", "must be positive")
+	}
+
+	history, err := s.historyUC.Exec(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*pb.StatusChange, 0, len(history))
+	for _, change := range history {
+		changes = append(changes, toPbStatusChange(change))
+	}
+
+	return &pb.GetOrderHistoryResponse{
+		Changes: changes,
+	}, nil
+}
+
+func (s *Server) WatchOrder(req *pb.WatchOrderRequest, stream pb.OrderService_WatchOrderServer) error {
+	violations := &domain.ValidationError{}
+	if req.OrderId <= 0 {
+		violations.Add("order_id", "must be positive")
+	}
+	if req.AfterVersion < 0 {
+		violations.Add("after_version", "must not be negative")
+	}
+	if err := violations.Err(); err != nil {
+		return err
+	}
+
+	return s.watchUC.Exec(stream.Context(), usecase.WatchOrderInput{
+		OrderID:      req.OrderId,
+		AfterVersion: req.AfterVersion,
+	}, func(update usecase.OrderUpdate) error {
+		return stream.Send(toPbOrderUpdate(update))
+	})
+}
+
+func (s *Server) RefundOrder(ctx context.Context,
+	req *pb.RefundOrderRequest) (*pb.RefundOrderResponse, error) {
+
+	if req.OrderId <= 0 {
+		return nil, domain.NewValidationError("order_id", "must be positive")
+	}
+
+	items := make([]domain.OrderItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, domain.OrderItem{
+			ProductID: item.ProductId,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	output, err := s.refundUC.Exec(ctx, usecase.RefundOrderInput{
+		OrderID: req.OrderId,
+		Reason:  domain.RefundReason(req.Reason),
+		Comment: req.Comment,
+		Actor:   domain.ActorSupport,
+		Items:   items,
+	})
+	if err != nil {
+		s.logger.Error("Failed to exec refund order usecase", zap.Int64("order_id", req.OrderId), zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.RefundOrderResponse{
+		Refund:        toPbRefund(output.Refund),
+		RefundedTotal: output.Refunded,
+		Refundable:    output.Refundable,
 	}, nil
 }
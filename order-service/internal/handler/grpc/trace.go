@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// TraceUnaryInterceptor copies W3C trace context from request metadata
+// into ctx, so events produced by the call carry it.
+func TraceUnaryInterceptor(ctx context.Context, req any,
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	trace := &eventspb.TraceContext{}
+	if values := md.Get(events.HeaderTraceparent); len(values) > 0 {
+		trace.Traceparent = values[0]
+	}
+	if values := md.Get(events.HeaderTracestate); len(values) > 0 {
+		trace.Tracestate = values[0]
+	}
+
+	return handler(events.ContextWithTrace(ctx, trace), req)
+}
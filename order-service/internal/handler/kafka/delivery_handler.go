@@ -0,0 +1,75 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/order-service/internal/usecase"
+	"github.com/Wuchinator/food-delivery/pkg/consumer"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// deliveryStatusPath maps delivery job status to the order statuses leading to it.
+var deliveryStatusPath = map[string][]domain.OrderStatus{
+	"PICKED_UP": {domain.OrderPickedUp},
+	"DELIVERED": {domain.OrderPickedUp, domain.OrderDelivered},
+}
+
+type DeliveryStatusHandler struct {
+	usecase *usecase.ChangeStatusUseCase
+	logger  *zap.Logger
+}
+
+func NewDeliveryStatusHandler(uc *usecase.ChangeStatusUseCase, logger *zap.Logger) *DeliveryStatusHandler {
+	return &DeliveryStatusHandler{
+		usecase: uc,
+		logger:  logger.Named("delivery_status_handler"),
+	}
+}
+
+func (h *DeliveryStatusHandler) Handle(ctx context.Context, msg kafka.Message) error {
+	env, err := events.Decode(header(msg, events.HeaderContentType), msg.Value)
+	if err != nil {
+		return consumer.Permanent(fmt.Errorf("decode delivery status event: %w", err))
+	}
+
+	event := env.GetDeliveryStatusChanged()
+	if event == nil {
+		h.logger.Warn("Skip unexpected event type", zap.String("type", env.Type))
+		return nil
+	}
+
+	path, ok := deliveryStatusPath[event.Status]
+	if !ok {
+		return consumer.Permanent(fmt.Errorf("unknown delivery status %q", event.Status))
+	}
+
+	ctx = events.ContextWithTrace(ctx, env.Trace)
+	err = h.usecase.Exec(ctx, usecase.ChangeStatusInput{
+		OrderID: event.OrderId,
+		Path:    path,
+		Actor:   domain.ActorCourier,
+		Reason:  "delivery " + event.Status,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidTransition) {
+			// E.g. the order was fully refunded before the courier took it.
+			h.logger.Warn("Delivery status is not applicable to order",
+				zap.Int64("order_id", event.OrderId),
+				zap.String("delivery_status", event.Status),
+				zap.Error(err))
+			return nil
+		}
+		return err
+	}
+
+	h.logger.Info("Delivery status applied",
+		zap.Int64("order_id", event.OrderId),
+		zap.String("delivery_status", event.Status))
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/order-service/internal/usecase"
+	"github.com/Wuchinator/food-delivery/pkg/consumer"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// kitchenStatusPath maps kitchen ticket status to the order statuses leading to it.
+var kitchenStatusPath = map[string][]domain.OrderStatus{
+	"ACCEPTED":  {domain.OrderAccepted},
+	"PREPARING": {domain.OrderAccepted, domain.OrderPreparing},
+	"READY":     {domain.OrderAccepted, domain.OrderPreparing, domain.OrderReadyForPickup},
+}
+
+type KitchenStatusHandler struct {
+	usecase *usecase.ChangeStatusUseCase
+	logger  *zap.Logger
+}
+
+func NewKitchenStatusHandler(uc *usecase.ChangeStatusUseCase, logger *zap.Logger) *KitchenStatusHandler {
+	return &KitchenStatusHandler{
+		usecase: uc,
+		logger:  logger.Named("kitchen_status_handler"),
+	}
+}
+
+func (h *KitchenStatusHandler) Handle(ctx context.Context, msg kafka.Message) error {
+	env, err := events.Decode(header(msg, events.HeaderContentType), msg.Value)
+	if err != nil {
+		return consumer.Permanent(fmt.Errorf("decode kitchen status event: %w", err))
+	}
+
+	event := env.GetKitchenStatusChanged()
+	if event == nil {
+		h.logger.Warn("Skip unexpected event type", zap.String("type", env.Type))
+		return nil
+	}
+
+	path, ok := kitchenStatusPath[event.Status]
+	if !ok {
+		return consumer.Permanent(fmt.Errorf("unknown kitchen status %q", event.Status))
+	}
+
+	ctx = events.ContextWithTrace(ctx, env.Trace)
+	err = h.usecase.Exec(ctx, usecase.ChangeStatusInput{
+		OrderID: event.OrderId,
+		Path:    path,
+		Actor:   domain.ActorRestaurant,
+		Reason:  "kitchen " + event.Status,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidTransition) {
+			// E.g. the order was cancelled while the kitchen was cooking.
+			h.logger.Warn("Kitchen status is not applicable to order",
+				zap.Int64("order_id", event.OrderId),
+				zap.String("kitchen_status", event.Status),
+				zap.Error(err))
+			return nil
+		}
+		return err
+	}
+
+	h.logger.Info("Kitchen status applied",
+		zap.Int64("order_id", event.OrderId),
+		zap.String("kitchen_status", event.Status))
+
+	return nil
+}
+
+func header(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
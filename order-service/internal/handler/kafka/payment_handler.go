@@ -0,0 +1,55 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/usecase"
+	"github.com/Wuchinator/food-delivery/pkg/consumer"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// PaymentHandler runs the authorize_payment saga step and its compensation
+// and captures the payment when the kitchen reports the order READY.
+type PaymentHandler struct {
+	authorize *usecase.AuthorizePaymentUseCase
+	void      *usecase.VoidPaymentUseCase
+	capture   *usecase.CapturePaymentUseCase
+	logger    *zap.Logger
+}
+
+func NewPaymentHandler(authorize *usecase.AuthorizePaymentUseCase, void *usecase.VoidPaymentUseCase,
+	capture *usecase.CapturePaymentUseCase, logger *zap.Logger) *PaymentHandler {
+	return &PaymentHandler{
+		authorize: authorize,
+		void:      void,
+		capture:   capture,
+		logger:    logger.Named("payment_handler"),
+	}
+}
+
+func (h *PaymentHandler) Handle(ctx context.Context, msg kafka.Message) error {
+	env, err := events.Decode(header(msg, events.HeaderContentType), msg.Value)
+	if err != nil {
+		return consumer.Permanent(fmt.Errorf("decode event: %w", err))
+	}
+
+	ctx = events.ContextWithTrace(ctx, env.Trace)
+	switch {
+	case env.GetSagaCommand().GetStep() == events.StepAuthorizePayment:
+		cmd := env.GetSagaCommand()
+		if cmd.Compensate {
+			return h.void.Exec(ctx, cmd.OrderId)
+		}
+		return h.authorize.Exec(ctx, cmd.OrderId)
+	case env.GetKitchenStatusChanged().GetStatus() == "READY":
+		return h.capture.Exec(ctx, env.GetKitchenStatusChanged().OrderId)
+	case env.GetKitchenStatusChanged() != nil:
+		return nil
+	default:
+		h.logger.Warn("Skip unexpected event type", zap.String("type", env.Type))
+		return nil
+	}
+}
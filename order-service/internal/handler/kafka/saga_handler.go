@@ -0,0 +1,59 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/order-service/internal/usecase"
+	"github.com/Wuchinator/food-delivery/pkg/consumer"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// SagaHandler starts the order saga on OrderCreated and feeds it with
+// participant replies.
+type SagaHandler struct {
+	usecase *usecase.OrderSagaUseCase
+	logger  *zap.Logger
+}
+
+func NewSagaHandler(uc *usecase.OrderSagaUseCase, logger *zap.Logger) *SagaHandler {
+	return &SagaHandler{
+		usecase: uc,
+		logger:  logger.Named("saga_handler"),
+	}
+}
+
+func (h *SagaHandler) Handle(ctx context.Context, msg kafka.Message) error {
+	env, err := events.Decode(header(msg, events.HeaderContentType), msg.Value)
+	if err != nil {
+		return consumer.Permanent(fmt.Errorf("decode saga event: %w", err))
+	}
+
+	ctx = events.ContextWithTrace(ctx, env.Trace)
+	switch {
+	case env.GetOrderCreated() != nil:
+		return h.usecase.Start(ctx, env.GetOrderCreated().OrderId)
+
+	case env.GetSagaReply() != nil:
+		reply := env.GetSagaReply()
+		err = h.usecase.HandleReply(ctx, reply.OrderId, domain.SagaReply{
+			Step:       domain.SagaStep(reply.Step),
+			Compensate: reply.Compensate,
+			Success:    reply.Success,
+			Reason:     reply.Reason,
+		})
+		if errors.Is(err, domain.ErrSagaNotFound) {
+			h.logger.Warn("Skip reply without saga", zap.Int64("order_id", reply.OrderId))
+			return nil
+		}
+		return err
+
+	default:
+		h.logger.Warn("Skip unexpected event type", zap.String("type", env.Type))
+		return nil
+	}
+}
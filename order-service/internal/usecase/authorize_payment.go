@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// AuthorizePaymentUseCase holds the order total with the payment provider and
+// reports the result through the outbox: the saga reply drives the order, and
+// PaymentSucceeded or PaymentFailed informs services outside order-service.
+// It is idempotent: orders with a processed payment are skipped.
+type AuthorizePaymentUseCase struct {
+	orders   domain.OrderRepository
+	payments domain.PaymentRepository
+	gateway  domain.PaymentGateway
+	logger   *zap.Logger
+	encoder  *events.Encoder
+}
+
+func NewAuthorizePaymentUseCase(orders domain.OrderRepository, payments domain.PaymentRepository,
+	gateway domain.PaymentGateway, logger *zap.Logger, encoder *events.Encoder) *AuthorizePaymentUseCase {
+	return &AuthorizePaymentUseCase{
+		orders:   orders,
+		payments: payments,
+		gateway:  gateway,
+		logger:   logger,
+		encoder:  encoder,
+	}
+}
+
+func (uc *AuthorizePaymentUseCase) Exec(ctx context.Context, orderID int64) error {
+	order, err := uc.orders.GetByID(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("Failed to get order %w", err)
+	}
+
+	payment, err := uc.pendingPayment(ctx, order)
+	if err != nil {
+		return err
+	}
+	if payment.Status != domain.PaymentPending {
+		uc.logger.Info("Payment is already processed",
+			zap.Int64("order_id", order.ID),
+			zap.String("status", string(payment.Status)))
+		return nil
+	}
+
+	if isClosed(order) {
+		// The failed payment lets a late command not hold the money of a closed order.
+		if err := payment.Fail("order is " + string(order.Status)); err != nil {
+			return err
+		}
+		return uc.save(ctx, payment, paymentFailedEvent(ctx, uc.encoder))
+	}
+
+	// The key is stable across retries, so the provider does not hold the money twice.
+	ref, err := uc.gateway.Authorize(ctx, domain.AuthorizeRequest{
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		Amount:         payment.Amount,
+		IdempotencyKey: "order-" + strconv.FormatInt(order.ID, 10),
+	})
+
+	switch {
+	case errors.Is(err, domain.ErrPaymentDeclined):
+		if err := payment.Fail(err.Error()); err != nil {
+			return err
+		}
+		return uc.save(ctx, payment, paymentFailedEvent(ctx, uc.encoder))
+	case err != nil:
+		uc.logger.Error("Failed to authorize payment", zap.Int64("order_id", order.ID), zap.Error(err))
+		return fmt.Errorf("Failed to authorize payment %w", err)
+	default:
+		if err := payment.Authorize(ref); err != nil {
+			return err
+		}
+		return uc.save(ctx, payment, paymentSucceededEvent(ctx, uc.encoder))
+	}
+}
+
+func (uc *AuthorizePaymentUseCase) save(ctx context.Context, payment *domain.Payment,
+	event domain.PaymentEventFactory) error {
+
+	err := uc.payments.Update(ctx, payment, domain.PaymentPending, event, authorizeReplyEvent(ctx, uc.encoder))
+	if err != nil {
+		uc.logger.Error("Failed to save payment", zap.Int64("order_id", payment.OrderID), zap.Error(err))
+		return fmt.Errorf("Failed to save payment %w", err)
+	}
+
+	uc.logger.Info("Payment processed",
+		zap.Int64("order_id", payment.OrderID),
+		zap.String("status", string(payment.Status)))
+	return nil
+}
+
+// pendingPayment returns the payment of the order creating it on first call.
+func (uc *AuthorizePaymentUseCase) pendingPayment(ctx context.Context, order *domain.Order) (*domain.Payment, error) {
+	payment, err := uc.payments.GetByOrderID(ctx, order.ID)
+	if err == nil || !errors.Is(err, domain.ErrPaymentNotFound) {
+		return payment, err
+	}
+
+	payment = domain.NewPayment(order, uc.gateway.Name())
+	payment.ID, err = uc.payments.Create(ctx, payment)
+	if errors.Is(err, domain.ErrPaymentExists) {
+		// Concurrent delivery of the same event won the race.
+		return uc.payments.GetByOrderID(ctx, order.ID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create payment %w", err)
+	}
+
+	return payment, nil
+}
+
+func paymentSucceededEvent(ctx context.Context, encoder *events.Encoder) domain.PaymentEventFactory {
+	return func(payment *domain.Payment) (*domain.OutboxMessage, error) {
+		return paymentOutboxMessage(ctx, encoder, domain.EventPaymentSucceeded, payment, &eventspb.PaymentSucceeded{
+			PaymentId:   payment.ID,
+			OrderId:     payment.OrderID,
+			UserId:      payment.UserID,
+			Amount:      payment.Amount,
+			Provider:    payment.Provider,
+			ProviderRef: payment.ProviderRef,
+		})
+	}
+}
+
+func paymentFailedEvent(ctx context.Context, encoder *events.Encoder) domain.PaymentEventFactory {
+	return func(payment *domain.Payment) (*domain.OutboxMessage, error) {
+		return paymentOutboxMessage(ctx, encoder, domain.EventPaymentFailed, payment, &eventspb.PaymentFailed{
+			PaymentId: payment.ID,
+			OrderId:   payment.OrderID,
+			UserId:    payment.UserID,
+			Amount:    payment.Amount,
+			Provider:  payment.Provider,
+			Reason:    payment.FailureReason,
+		})
+	}
+}
+
+// authorizeReplyEvent replies to the authorize_payment saga step.
+func authorizeReplyEvent(ctx context.Context, encoder *events.Encoder) domain.PaymentEventFactory {
+	return func(payment *domain.Payment) (*domain.OutboxMessage, error) {
+		return paymentOutboxMessage(ctx, encoder, domain.EventSagaReply, payment, &eventspb.SagaReply{
+			OrderId:   payment.OrderID,
+			Step:      string(domain.SagaAuthorizePayment),
+			Success:   payment.Status == domain.PaymentAuthorized,
+			Reason:    payment.FailureReason,
+			Reference: payment.ProviderRef,
+		})
+	}
+}
+
+func paymentOutboxMessage(ctx context.Context, encoder *events.Encoder, eventType string,
+	payment *domain.Payment, payload proto.Message) (*domain.OutboxMessage, error) {
+
+	env, err := events.New(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := encoder.Encode(env)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OutboxMessage{
+		EventType:   eventType,
+		Key:         strconv.FormatInt(payment.OrderID, 10),
+		Payload:     data,
+		ContentType: encoder.ContentType(),
+	}, nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"go.uber.org/zap"
+)
+
+type CancelOrderInput struct {
+	OrderID int64
+	Actor   string
+	Reason  string
+}
+
+// CancelOrderUseCase cancels an order and publishes OrderCancelled through the
+// outbox in the same transaction.
+type CancelOrderUseCase struct {
+	repo    domain.OrderRepository
+	logger  *zap.Logger
+	encoder *events.Encoder
+}
+
+func NewCancelOrderUseCase(repo domain.OrderRepository, logger *zap.Logger, encoder *events.Encoder) *CancelOrderUseCase {
+	return &CancelOrderUseCase{
+		repo:    repo,
+		logger:  logger,
+		encoder: encoder,
+	}
+}
+
+func (uc *CancelOrderUseCase) Exec(ctx context.Context, input CancelOrderInput) error {
+	order, err := uc.repo.GetByID(ctx, input.OrderID)
+	if err != nil {
+		uc.logger.Error("Failed to get order", zap.Int64("order_id", input.OrderID), zap.Error(err))
+		return fmt.Errorf("Failed to get order %w", err)
+	}
+
+	prevStatus := order.Status
+	if err := order.Cancel(); err != nil {
+		uc.logger.Warn("Order can not be cancelled",
+			zap.Int64("order_id", order.ID),
+			zap.String("status", string(prevStatus)))
+		return err
+	}
+
+	msg, err := orderCancelledMessage(ctx, uc.encoder, order, input.Reason)
+	if err != nil {
+		return fmt.Errorf("Failed to build order cancelled event %w", err)
+	}
+
+	err = uc.repo.UpdateStatus(ctx, domain.StatusChange{
+		OrderID:   order.ID,
+		From:      prevStatus,
+		To:        order.Status,
+		Actor:     input.Actor,
+		Reason:    input.Reason,
+		ChangedAt: order.UpdatedAt,
+	}, msg)
+	if err != nil {
+		uc.logger.Error("Failed to cancel order", zap.Int64("order_id", order.ID), zap.Error(err))
+		return fmt.Errorf("Failed to cancel order %w", err)
+	}
+
+	return nil
+}
+
+func orderCancelledMessage(ctx context.Context, encoder *events.Encoder, order *domain.Order,
+	reason string) (*domain.OutboxMessage, error) {
+
+	env, err := events.New(ctx, &eventspb.OrderCancelled{
+		OrderId:      order.ID,
+		UserId:       order.UserID,
+		RestaurantId: order.RestaurantID,
+		Reason:       reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := encoder.Encode(env)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OutboxMessage{
+		EventType:   domain.EventOrderCancelled,
+		Key:         strconv.FormatInt(order.UserID, 10),
+		Payload:     payload,
+		ContentType: encoder.ContentType(),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+// CapturePaymentUseCase charges the authorized order total once the food is ready.
+// Capturing an already captured payment is a no-op.
+type CapturePaymentUseCase struct {
+	payments domain.PaymentRepository
+	gateway  domain.PaymentGateway
+	logger   *zap.Logger
+}
+
+func NewCapturePaymentUseCase(payments domain.PaymentRepository, gateway domain.PaymentGateway,
+	logger *zap.Logger) *CapturePaymentUseCase {
+	return &CapturePaymentUseCase{
+		payments: payments,
+		gateway:  gateway,
+		logger:   logger,
+	}
+}
+
+func (uc *CapturePaymentUseCase) Exec(ctx context.Context, orderID int64) error {
+	payment, err := uc.payments.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("Failed to get payment %w", err)
+	}
+
+	if payment.Status == domain.PaymentCaptured {
+		return nil
+	}
+
+	if err := payment.Capture(); err != nil {
+		uc.logger.Warn("Payment can not be captured",
+			zap.Int64("order_id", orderID),
+			zap.String("status", string(payment.Status)))
+		return err
+	}
+
+	if err := uc.gateway.Capture(ctx, payment.ProviderRef, payment.Amount); err != nil {
+		uc.logger.Error("Failed to capture payment", zap.Int64("order_id", orderID), zap.Error(err))
+		return fmt.Errorf("Failed to capture payment %w", err)
+	}
+
+	if err := uc.payments.Update(ctx, payment, domain.PaymentAuthorized); err != nil {
+		uc.logger.Error("Failed to save captured payment", zap.Int64("order_id", orderID), zap.Error(err))
+		return fmt.Errorf("Failed to save captured payment %w", err)
+	}
+
+	uc.logger.Info("Payment captured", zap.Int64("order_id", orderID), zap.Int64("amount", payment.Amount))
+	return nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+type ChangeStatusInput struct {
+	OrderID int64
+	// Path is the chain of statuses leading to the target one, the last
+	// element is the target. Steps the order has already passed are skipped,
+	// so a missed intermediate event does not block later ones.
+	Path   []domain.OrderStatus
+	Actor  string
+	Reason string
+}
+
+// ChangeStatusUseCase applies status changes coming from other services.
+// It is idempotent: applying a status the order already has is a no-op.
+type ChangeStatusUseCase struct {
+	repo   domain.OrderRepository
+	logger *zap.Logger
+}
+
+func NewChangeStatusUseCase(repo domain.OrderRepository, logger *zap.Logger) *ChangeStatusUseCase {
+	return &ChangeStatusUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (uc *ChangeStatusUseCase) Exec(ctx context.Context, input ChangeStatusInput) error {
+	if len(input.Path) == 0 {
+		return nil
+	}
+
+	order, err := uc.repo.GetByID(ctx, input.OrderID)
+	if err != nil {
+		return fmt.Errorf("Failed to get order %w", err)
+	}
+
+	steps := input.Path
+	if i := slices.Index(steps, order.Status); i >= 0 {
+		steps = steps[i+1:]
+	}
+
+	for _, to := range steps {
+		from := order.Status
+		if err := order.Transition(to); err != nil {
+			return err
+		}
+
+		err := uc.repo.UpdateStatus(ctx, domain.StatusChange{
+			OrderID:   order.ID,
+			From:      from,
+			To:        to,
+			Actor:     input.Actor,
+			Reason:    input.Reason,
+			ChangedAt: order.UpdatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to change order status %w", err)
+		}
+	}
+
+	return nil
+}
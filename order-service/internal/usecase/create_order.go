@@ -1,14 +1,20 @@
 package usecase
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"math/rand"
+	"slices"
+	"strconv"
 	"time"
 
-	"github.com/Wuchinator/food-delivery/order-service/internal/adapter/kafka"
 	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"github.com/Wuchinator/food-delivery/pkg/geo"
 	"go.uber.org/zap"
 )
 
@@ -16,7 +22,16 @@ type CreateOrderInput struct {
 	UserID       int64
 	RestaurantID int64
 	Items        []CreateOrderItemInput
-	Address      string
+	Address      domain.DeliveryAddress
+	// ScheduledFor is the requested delivery time, zero means as soon as possible.
+	ScheduledFor time.Time
+	// IdempotencyKey is optional, replays with the same key return the original order.
+	IdempotencyKey string
+}
+
+type CreateOrderOutput struct {
+	OrderID int64
+	Status  domain.OrderStatus
 }
 
 type CreateOrderItemInput struct {
@@ -24,69 +39,292 @@ type CreateOrderItemInput struct {
 	Quantity  int32
 }
 
-type KafkaProducer interface {
-	SentOrCreated(ctx context.Context, event kafka.OrderCreatedEvent) error
+// MenuPricer returns authoritative price per product id from the restaurant menu.
+// Unknown or unavailable products must fail with domain.ErrProductUnavailable.
+type MenuPricer interface {
+	Prices(ctx context.Context, restaurantID int64, productIDs []int64) (map[int64]int64, error)
+}
+
+// Geocoder resolves coordinates of an address, unknown addresses must fail
+// with domain.ErrAddressNotFound.
+type Geocoder interface {
+	Geocode(ctx context.Context, address domain.DeliveryAddress) (geo.Point, error)
+}
+
+// DeliveryChecker returns domain.ErrAddressNotServed if the restaurant
+// does not deliver to point.
+type DeliveryChecker interface {
+	CheckDeliverable(ctx context.Context, restaurantID int64, point geo.Point) error
+}
+
+// AvailabilityChecker returns domain.ErrRestaurantClosed if the restaurant
+// does not accept orders at the given moment.
+type AvailabilityChecker interface {
+	CheckOpen(ctx context.Context, restaurantID int64, at time.Time) error
+}
+
+// PrepTimer returns how long the restaurant kitchen usually cooks an order.
+type PrepTimer interface {
+	PrepTime(ctx context.Context, restaurantID int64) (time.Duration, error)
 }
 
 // Strcut of dependecies
 type CreateOrderUseCase struct {
-	repo   domain.OrderRepository
-	logger *zap.Logger
-	kafka  KafkaProducer
+	repo     domain.OrderRepository
+	logger   *zap.Logger
+	pricer   MenuPricer
+	geocoder Geocoder
+	delivery DeliveryChecker
+	hours    AvailabilityChecker
+	prep     PrepTimer
+	encoder  *events.Encoder
 }
 
-func NewCreateOrderUseCase(repo domain.OrderRepository, logger *zap.Logger, kafka KafkaProducer) *CreateOrderUseCase {
+func NewCreateOrderUseCase(repo domain.OrderRepository, logger *zap.Logger, pricer MenuPricer,
+	geocoder Geocoder, delivery DeliveryChecker, hours AvailabilityChecker, prep PrepTimer,
+	encoder *events.Encoder) *CreateOrderUseCase {
 	return &CreateOrderUseCase{
-		repo:   repo,
-		logger: logger,
-		kafka:  kafka,
+		repo:     repo,
+		logger:   logger,
+		pricer:   pricer,
+		geocoder: geocoder,
+		delivery: delivery,
+		hours:    hours,
+		prep:     prep,
+		encoder:  encoder,
 	}
 }
 
-func (uc *CreateOrderUseCase) Exec(ctx context.Context, input CreateOrderInput) (int64, error) {
-
-	if len(input.Items) == 0 {
-		uc.logger.Error("goods can not be empty")
-		return 0, errors.New("goods can not be empty")
-	}
+func (uc *CreateOrderUseCase) Exec(ctx context.Context, input CreateOrderInput) (*CreateOrderOutput, error) {
 
 	orderItems := make([]domain.OrderItem, 0, len(input.Items))
-
 	for _, item := range input.Items {
-		fakePrice := rand.Intn(10) // Hardcode
 		orderItems = append(orderItems, domain.OrderItem{
 			ProductID: item.ProductID,
 			Quantity:  item.Quantity,
-			Price:     int64(fakePrice),
 		})
 	}
 
-	order, err := domain.NewOrder(input.UserID, input.RestaurantID, orderItems)
+	// Validate before going to restaurant-service, all violations are returned at once.
+	if err := domain.ValidateOrder(input.UserID, input.RestaurantID, orderItems, input.Address); err != nil {
+		uc.logger.Warn("Invalid create order request", zap.Error(err))
+		return nil, err
+	}
+
+	var requestHash string
+	if input.IdempotencyKey != "" {
+		requestHash = hashCreateOrderInput(input)
+
+		output, err := uc.replay(ctx, input, requestHash)
+		if err == nil || !errors.Is(err, domain.ErrOrderNotFound) {
+			return output, err
+		}
+	}
+
+	// Scheduled orders must be cookable when they are released to the kitchen.
+	now := time.Now()
+	acceptAt := now
+	var releaseAt time.Time
+	if !input.ScheduledFor.IsZero() {
+		prepTime, err := uc.prep.PrepTime(ctx, input.RestaurantID)
+		if err != nil {
+			uc.logger.Error("Failed to get restaurant prep time",
+				zap.Int64("restaurant_id", input.RestaurantID), zap.Error(err))
+			return nil, fmt.Errorf("Failed to get restaurant prep time %w", err)
+		}
+
+		releaseAt, err = domain.ReleaseTime(input.ScheduledFor, now, prepTime)
+		if err != nil {
+			uc.logger.Warn("Invalid order schedule", zap.Error(err))
+			return nil, err
+		}
+		acceptAt = releaseAt
+	}
+
+	if err := uc.hours.CheckOpen(ctx, input.RestaurantID, acceptAt); err != nil {
+		uc.logger.Warn("Restaurant does not accept orders",
+			zap.Int64("restaurant_id", input.RestaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	address, err := uc.locate(ctx, input.RestaurantID, input.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	orderItems = domain.MergeItems(orderItems)
+
+	productIDs := make([]int64, 0, len(orderItems))
+	for _, item := range orderItems {
+		productIDs = append(productIDs, item.ProductID)
+	}
+
+	prices, err := uc.pricer.Prices(ctx, input.RestaurantID, productIDs)
+	if err != nil {
+		uc.logger.Error("Failed to price order items", zap.Int64("restaurant_id", input.RestaurantID), zap.Error(err))
+		return nil, fmt.Errorf("Failed to price order items %w", err)
+	}
+
+	for i := range orderItems {
+		orderItems[i].Price = prices[orderItems[i].ProductID]
+	}
+
+	order, err := domain.NewOrder(input.UserID, input.RestaurantID, orderItems, address)
 	if err != nil {
 		uc.logger.Error("Failed to init new order", zap.Error(err))
+		return nil, err
+	}
+
+	order.IdempotencyKey = input.IdempotencyKey
+	order.RequestHash = requestHash
+
+	// OrderCreated event is written to outbox in the same transaction
+	// and delivered to Kafka by the outbox relay. Scheduled orders get it
+	// when the scheduler releases them.
+	event := orderCreatedEvent(ctx, uc.encoder)
+	if !input.ScheduledFor.IsZero() {
+		order.Schedule(input.ScheduledFor, releaseAt)
+		event = nil
 	}
 
-	orderID, err := uc.repo.Create(ctx, order)
+	orderID, err := uc.repo.Create(ctx, order, event)
 
 	if err != nil {
+		if errors.Is(err, domain.ErrIdempotencyConflict) {
+			// Concurrent retry with the same key won the race.
+			return uc.replay(ctx, input, requestHash)
+		}
 		uc.logger.Error("Failed to create order", zap.Error(err))
-		return 0, fmt.Errorf("Failed to create order %w", err)
+		return nil, fmt.Errorf("Failed to create order %w", err)
 	}
 
-	order.ID = orderID
+	return &CreateOrderOutput{
+		OrderID: orderID,
+		Status:  order.Status,
+	}, nil
+}
+
+// locate geocodes the address unless the client sent coordinates and checks
+// that the restaurant delivers there.
+func (uc *CreateOrderUseCase) locate(ctx context.Context,
+	restaurantID int64, address domain.DeliveryAddress) (domain.DeliveryAddress, error) {
+
+	if !address.HasLocation() {
+		point, err := uc.geocoder.Geocode(ctx, address)
+		if err != nil {
+			uc.logger.Warn("Failed to geocode delivery address", zap.Error(err))
+			if errors.Is(err, domain.ErrAddressNotFound) {
+				violations := &domain.ValidationError{}
+				violations.AddErr("address", err)
+				return address, violations
+			}
+			return address, fmt.Errorf("Failed to geocode delivery address %w", err)
+		}
+		address.Lat, address.Lng = point.Lat, point.Lng
+	}
 
-	event := kafka.OrderCreatedEvent{
-		OrderID:   order.ID,
-		UserID:    order.UserID,
-		Timestamp: time.Now(),
+	if err := uc.delivery.CheckDeliverable(ctx, restaurantID, address.Point()); err != nil {
+		uc.logger.Warn("Delivery address is not served",
+			zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return address, err
 	}
 
-	err = uc.kafka.SentOrCreated(ctx, event)
+	return address, nil
+}
+
+// replay returns the order previously created with the same idempotency key.
+func (uc *CreateOrderUseCase) replay(ctx context.Context,
+	input CreateOrderInput, requestHash string) (*CreateOrderOutput, error) {
+
+	order, err := uc.repo.GetByIdempotencyKey(ctx, input.UserID, input.IdempotencyKey)
 	if err != nil {
-		uc.logger.Error("order created, but failed to send kafka event",
-			zap.Int64("ID", order.ID),
-			zap.Error(err))
+		return nil, err
+	}
+
+	if order.RequestHash != requestHash {
+		uc.logger.Warn("Idempotency key reused with different payload",
+			zap.Int64("user_id", input.UserID),
+			zap.Int64("order_id", order.ID))
+		return nil, domain.ErrIdempotencyKeyReuse
+	}
+
+	uc.logger.Info("Replayed create order request", zap.Int64("order_id", order.ID))
+	return &CreateOrderOutput{
+		OrderID: order.ID,
+		Status:  order.Status,
+	}, nil
+}
+
+// hashCreateOrderInput fingerprints the request payload, item order does not matter.
+func hashCreateOrderInput(input CreateOrderInput) string {
+	items := slices.Clone(input.Items)
+	slices.SortFunc(items, func(a, b CreateOrderItemInput) int {
+		if c := cmp.Compare(a.ProductID, b.ProductID); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Quantity, b.Quantity)
+	})
+
+	h := sha256.New()
+	address := input.Address.Normalize()
+	fmt.Fprintf(h, "%d|%d|%q|%q|%q|%q|%g|%g", input.UserID, input.RestaurantID,
+		address.Street, address.Apartment, address.City, address.Comment, address.Lat, address.Lng)
+	if !input.ScheduledFor.IsZero() {
+		fmt.Fprintf(h, "|at:%d", input.ScheduledFor.Unix())
+	}
+	for _, item := range items {
+		fmt.Fprintf(h, "|%d:%d", item.ProductID, item.Quantity)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// orderCreatedEvent returns the outbox factory, ctx carries the trace context for the envelope.
+func orderCreatedEvent(ctx context.Context, encoder *events.Encoder) domain.EventFactory {
+	return func(order *domain.Order) (*domain.OutboxMessage, error) {
+		env, err := events.New(ctx, orderCreatedPayload(order))
+		if err != nil {
+			return nil, err
+		}
+
+		payload, err := encoder.Encode(env)
+		if err != nil {
+			return nil, err
+		}
+
+		return &domain.OutboxMessage{
+			EventType:   domain.EventOrderCreated,
+			Key:         strconv.FormatInt(order.UserID, 10),
+			Payload:     payload,
+			ContentType: encoder.ContentType(),
+		}, nil
+	}
+}
+
+func orderCreatedPayload(order *domain.Order) *eventspb.OrderCreated {
+	items := make([]*eventspb.OrderItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, &eventspb.OrderItem{
+			ProductId: item.ProductID,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+		})
 	}
 
-	return order.ID, nil
+	return &eventspb.OrderCreated{
+		OrderId:         order.ID,
+		UserId:          order.UserID,
+		RestaurantId:    order.RestaurantID,
+		Items:           items,
+		Total:           order.Total,
+		DeliveryAddress: order.DeliveryAddress.String(),
+		Address: &eventspb.Address{
+			Street:    order.DeliveryAddress.Street,
+			Apartment: order.DeliveryAddress.Apartment,
+			City:      order.DeliveryAddress.City,
+			Lat:       order.DeliveryAddress.Lat,
+			Lng:       order.DeliveryAddress.Lng,
+			Comment:   order.DeliveryAddress.Comment,
+		},
+	}
 }
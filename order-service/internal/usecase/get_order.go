@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+type GetOrderUseCase struct {
+	repo   domain.OrderRepository
+	logger *zap.Logger
+}
+
+func NewGetOrderUseCase(repo domain.OrderRepository, logger *zap.Logger) *GetOrderUseCase {
+	return &GetOrderUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (uc *GetOrderUseCase) Exec(ctx context.Context, orderID int64) (*domain.Order, error) {
+	order, err := uc.repo.GetByID(ctx, orderID)
+	if err != nil {
+		uc.logger.Error("Failed to get order", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("Failed to get order %w", err)
+	}
+
+	return order, nil
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+type GetOrderHistoryUseCase struct {
+	repo   domain.OrderRepository
+	logger *zap.Logger
+}
+
+func NewGetOrderHistoryUseCase(repo domain.OrderRepository, logger *zap.Logger) *GetOrderHistoryUseCase {
+	return &GetOrderHistoryUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (uc *GetOrderHistoryUseCase) Exec(ctx context.Context, orderID int64) ([]domain.StatusChange, error) {
+	// History of unknown order is empty, check the order itself to answer NotFound.
+	if _, err := uc.repo.GetByID(ctx, orderID); err != nil {
+		return nil, fmt.Errorf("Failed to get order %w", err)
+	}
+
+	history, err := uc.repo.History(ctx, orderID)
+	if err != nil {
+		uc.logger.Error("Failed to get order history", zap.Int64("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("Failed to get order history %w", err)
+	}
+
+	return history, nil
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type ListOrdersInput struct {
+	UserID       int64
+	RestaurantID int64
+	Status       domain.OrderStatus
+	CreatedFrom  time.Time
+	CreatedTo    time.Time
+	PageSize     int
+	AfterID      int64
+}
+
+type ListOrdersOutput struct {
+	Orders []domain.Order
+	// NextAfterID is zero when there are no more pages.
+	NextAfterID int64
+}
+
+type ListOrdersUseCase struct {
+	repo   domain.OrderRepository
+	logger *zap.Logger
+}
+
+func NewListOrdersUseCase(repo domain.OrderRepository, logger *zap.Logger) *ListOrdersUseCase {
+	return &ListOrdersUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (uc *ListOrdersUseCase) Exec(ctx context.Context, input ListOrdersInput) (*ListOrdersOutput, error) {
+	pageSize := input.PageSize
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	// Take one extra row to know if there is a next page.
+	orders, err := uc.repo.List(ctx, domain.OrderFilter{
+		UserID:       input.UserID,
+		RestaurantID: input.RestaurantID,
+		Status:       input.Status,
+		CreatedFrom:  input.CreatedFrom,
+		CreatedTo:    input.CreatedTo,
+		AfterID:      input.AfterID,
+		Limit:        pageSize + 1,
+	})
+	if err != nil {
+		uc.logger.Error("Failed to list orders", zap.Error(err))
+		return nil, fmt.Errorf("Failed to list orders %w", err)
+	}
+
+	output := &ListOrdersOutput{Orders: orders}
+	if len(orders) > pageSize {
+		output.Orders = orders[:pageSize]
+		output.NextAfterID = output.Orders[pageSize-1].ID
+	}
+
+	return output, nil
+}
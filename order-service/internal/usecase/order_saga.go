@@ -0,0 +1,318 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"go.uber.org/zap"
+)
+
+// maxSagaUpdateAttempts bounds retries of a saga update lost to a concurrent
+// change of the saga or its order.
+const maxSagaUpdateAttempts = 3
+
+type OrderSagaConfig struct {
+	// StepTimeout is how long a step or compensation may wait for its reply.
+	StepTimeout time.Duration
+	// MaxCompensationAttempts is how many times compensations are sent before
+	// the saga gives up on them.
+	MaxCompensationAttempts int
+}
+
+// OrderSagaUseCase orchestrates a created order through item reservation,
+// payment authorization and the kitchen ticket. Commands go to participants
+// through the outbox together with the saga state. On failure the order is
+// closed in the same transaction and completed steps are compensated.
+type OrderSagaUseCase struct {
+	sagas   domain.SagaRepository
+	orders  domain.OrderRepository
+	encoder *events.Encoder
+	cfg     OrderSagaConfig
+	logger  *zap.Logger
+}
+
+func NewOrderSagaUseCase(sagas domain.SagaRepository, orders domain.OrderRepository,
+	encoder *events.Encoder, cfg OrderSagaConfig, logger *zap.Logger) *OrderSagaUseCase {
+	return &OrderSagaUseCase{
+		sagas:   sagas,
+		orders:  orders,
+		encoder: encoder,
+		cfg:     cfg,
+		logger:  logger,
+	}
+}
+
+// Start begins the saga of a created order. Starting it again is a no-op.
+func (uc *OrderSagaUseCase) Start(ctx context.Context, orderID int64) error {
+	order, err := uc.orders.GetByID(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("Failed to get order %w", err)
+	}
+
+	if order.Status != domain.OrderCreated {
+		uc.logger.Info("Skip saga of order not in Created",
+			zap.Int64("order_id", order.ID),
+			zap.String("status", string(order.Status)))
+		return nil
+	}
+
+	saga, commands := domain.NewSaga(order.ID, time.Now(), uc.cfg.StepTimeout)
+	messages, err := uc.commandMessages(ctx, order, commands)
+	if err != nil {
+		return err
+	}
+
+	err = uc.sagas.Create(ctx, saga, messages)
+	if errors.Is(err, domain.ErrSagaExists) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to create saga %w", err)
+	}
+
+	uc.logger.Info("Order saga started", zap.Int64("order_id", order.ID))
+	return nil
+}
+
+// HandleReply moves the saga on a participant reply. Stale replies are skipped.
+func (uc *OrderSagaUseCase) HandleReply(ctx context.Context, orderID int64, reply domain.SagaReply) error {
+	for attempt := 1; ; attempt++ {
+		err := uc.handleReply(ctx, orderID, reply)
+		if isSagaRace(err) && attempt < maxSagaUpdateAttempts {
+			continue
+		}
+		return err
+	}
+}
+
+func (uc *OrderSagaUseCase) handleReply(ctx context.Context, orderID int64, reply domain.SagaReply) error {
+	saga, order, err := uc.load(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	prevStatus := saga.Status
+	now := time.Now()
+
+	var commands []domain.SagaCommand
+	if saga.Status == domain.SagaRunning && isClosed(order) {
+		// E.g. the customer cancelled the order while the saga was running.
+		commands = saga.Abort("order is "+string(order.Status), now, uc.cfg.StepTimeout)
+	} else {
+		commands, err = saga.Apply(reply, now, uc.cfg.StepTimeout)
+		if errors.Is(err, domain.ErrSagaStaleReply) {
+			uc.logger.Warn("Skip stale saga reply", zap.Int64("order_id", orderID), zap.Error(err))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var changes []domain.StatusChange
+	paid := reply.Step == domain.SagaAuthorizePayment && reply.Success && !reply.Compensate
+	if saga.Status == domain.SagaRunning && paid && order.Status != domain.OrperPaid {
+		// The order is paid before the kitchen is asked to confirm it. The status
+		// is saved with the saga, so a step timeout never sees a paid order
+		// with the payment step still pending.
+		from := order.Status
+		if err := order.Transition(domain.OrperPaid); err != nil {
+			return fmt.Errorf("Failed to mark order as paid %w", err)
+		}
+		changes = append(changes, domain.StatusChange{
+			OrderID:   order.ID,
+			From:      from,
+			To:        order.Status,
+			Actor:     domain.ActorSystem,
+			Reason:    "payment authorized",
+			ChangedAt: order.UpdatedAt,
+		})
+	}
+
+	return uc.save(ctx, saga, prevStatus, order, commands, changes...)
+}
+
+// ExpireDue handles up to limit sagas whose deadline passed at now and
+// returns how many were handled.
+func (uc *OrderSagaUseCase) ExpireDue(ctx context.Context, now time.Time, limit int) (int, error) {
+	ids, err := uc.sagas.Due(ctx, now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to get due sagas %w", err)
+	}
+
+	expired := 0
+	for _, id := range ids {
+		err := uc.expire(ctx, id, now)
+		if isSagaRace(err) {
+			// A reply arrived or the order changed in the meantime.
+			continue
+		}
+		if err != nil {
+			return expired, err
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+func (uc *OrderSagaUseCase) expire(ctx context.Context, orderID int64, now time.Time) error {
+	saga, order, err := uc.load(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	prevStatus := saga.Status
+	commands := saga.Timeout(now, uc.cfg.StepTimeout, uc.cfg.MaxCompensationAttempts)
+	if saga.Status == domain.SagaFailed && prevStatus == domain.SagaCompensating {
+		uc.logger.Error("Saga compensation gave up",
+			zap.Int64("order_id", orderID),
+			zap.String("reason", saga.FailureReason))
+	}
+
+	return uc.save(ctx, saga, prevStatus, order, commands)
+}
+
+func (uc *OrderSagaUseCase) load(ctx context.Context, orderID int64) (*domain.Saga, *domain.Order, error) {
+	saga, err := uc.sagas.Get(ctx, orderID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to get saga %w", err)
+	}
+
+	order, err := uc.orders.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to get order %w", err)
+	}
+
+	return saga, order, nil
+}
+
+func (uc *OrderSagaUseCase) save(ctx context.Context, saga *domain.Saga, prevStatus domain.SagaStatus,
+	order *domain.Order, commands []domain.SagaCommand, changes ...domain.StatusChange) error {
+
+	messages, err := uc.commandMessages(ctx, order, commands)
+	if err != nil {
+		return err
+	}
+
+	if prevStatus == domain.SagaRunning && saga.Status != domain.SagaRunning && saga.Status != domain.SagaCompleted {
+		// The order is closed with the failed saga, so it never stays open
+		// while its payment is voided.
+		change, event, err := uc.closeOrder(ctx, order, saga.FailureReason)
+		if err != nil {
+			return err
+		}
+		if change != nil {
+			changes = append(changes, *change)
+		}
+		if event != nil {
+			messages = append(messages, event)
+		}
+	}
+
+	if err := uc.sagas.Update(ctx, saga, messages, changes...); err != nil {
+		return fmt.Errorf("Failed to update saga %w", err)
+	}
+
+	uc.logger.Info("Order saga updated",
+		zap.Int64("order_id", saga.OrderID),
+		zap.String("status", string(saga.Status)),
+		zap.String("step", string(saga.Step)),
+		zap.Int("commands", len(commands)))
+	return nil
+}
+
+// closeOrder cancels the order of a failed saga or rejects it if it is
+// already accepted and returns the status change with OrderCancelled of a
+// cancelled order. Orders closed by someone else are left as is.
+func (uc *OrderSagaUseCase) closeOrder(ctx context.Context, order *domain.Order,
+	reason string) (*domain.StatusChange, *domain.OutboxMessage, error) {
+
+	var to domain.OrderStatus
+	switch {
+	case order.Status.CanTransitionTo(domain.OrderCancelled):
+		to = domain.OrderCancelled
+	case order.Status.CanTransitionTo(domain.OrderRejected):
+		to = domain.OrderRejected
+	default:
+		return nil, nil, nil
+	}
+
+	from := order.Status
+	if err := order.Transition(to); err != nil {
+		return nil, nil, fmt.Errorf("Failed to close order %w", err)
+	}
+
+	change := &domain.StatusChange{
+		OrderID:   order.ID,
+		From:      from,
+		To:        order.Status,
+		Actor:     domain.ActorSystem,
+		Reason:    reason,
+		ChangedAt: order.UpdatedAt,
+	}
+	if to != domain.OrderCancelled {
+		return change, nil, nil
+	}
+
+	event, err := orderCancelledMessage(ctx, uc.encoder, order, reason)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to build order cancelled event %w", err)
+	}
+
+	return change, event, nil
+}
+
+func (uc *OrderSagaUseCase) commandMessages(ctx context.Context, order *domain.Order,
+	commands []domain.SagaCommand) ([]*domain.OutboxMessage, error) {
+
+	messages := make([]*domain.OutboxMessage, 0, len(commands))
+	for _, command := range commands {
+		cmd := &eventspb.SagaCommand{
+			OrderId:    order.ID,
+			Step:       string(command.Step),
+			Compensate: command.Compensate,
+		}
+
+		eventType := domain.EventRestaurantCommand
+		if command.Step == domain.SagaAuthorizePayment {
+			eventType = domain.EventPaymentCommand
+		} else if !command.Compensate {
+			cmd.Order = orderCreatedPayload(order)
+		}
+
+		env, err := events.New(ctx, cmd)
+		if err != nil {
+			return nil, err
+		}
+
+		payload, err := uc.encoder.Encode(env)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, &domain.OutboxMessage{
+			EventType:   eventType,
+			Key:         strconv.FormatInt(order.ID, 10),
+			Payload:     payload,
+			ContentType: uc.encoder.ContentType(),
+		})
+	}
+
+	return messages, nil
+}
+
+// isSagaRace reports whether the saga or its order changed since they were loaded.
+func isSagaRace(err error) bool {
+	return errors.Is(err, domain.ErrSagaConflict) || errors.Is(err, domain.ErrOrderStatusConflict)
+}
+
+func isClosed(order *domain.Order) bool {
+	return order.Status == domain.OrderCancelled || order.Status == domain.OrderRejected
+}
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"go.uber.org/zap"
+)
+
+type RefundOrderInput struct {
+	OrderID int64
+	Reason  domain.RefundReason
+	Comment string
+	Actor   string
+	// Items to refund, empty refunds everything not refunded yet.
+	Items []domain.OrderItem
+}
+
+type RefundOrderOutput struct {
+	Refund *domain.Refund
+	// Refunded is the total of all refunds of the order including this one.
+	Refunded int64
+	// Refundable is what is left of the captured amount.
+	Refundable int64
+}
+
+// maxRefundCompleteAttempts bounds retries of saving a refund whose order
+// changed status concurrently.
+const maxRefundCompleteAttempts = 3
+
+// RefundOrderUseCase returns money of a captured payment to the customer,
+// either for specific dishes or the rest of the order. The refund is recorded
+// in the ledger before the provider is called, so refunds running at the same
+// time can not exceed the captured amount. Every succeeded refund publishes
+// OrderRefunded through the outbox, a full one also moves the order into Refunded.
+type RefundOrderUseCase struct {
+	orders   domain.OrderRepository
+	payments domain.PaymentRepository
+	refunds  domain.RefundRepository
+	gateway  domain.PaymentGateway
+	logger   *zap.Logger
+	encoder  *events.Encoder
+}
+
+func NewRefundOrderUseCase(orders domain.OrderRepository, payments domain.PaymentRepository,
+	refunds domain.RefundRepository, gateway domain.PaymentGateway, logger *zap.Logger,
+	encoder *events.Encoder) *RefundOrderUseCase {
+	return &RefundOrderUseCase{
+		orders:   orders,
+		payments: payments,
+		refunds:  refunds,
+		gateway:  gateway,
+		logger:   logger,
+		encoder:  encoder,
+	}
+}
+
+func (uc *RefundOrderUseCase) Exec(ctx context.Context, input RefundOrderInput) (*RefundOrderOutput, error) {
+	order, err := uc.orders.GetByID(ctx, input.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get order %w", err)
+	}
+
+	payment, err := uc.payments.GetByOrderID(ctx, order.ID)
+	if errors.Is(err, domain.ErrPaymentNotFound) {
+		return nil, fmt.Errorf("%w: order %d has no payment", domain.ErrPaymentNotCaptured, order.ID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get payment %w", err)
+	}
+
+	ledger, err := uc.refunds.ListByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get refunds %w", err)
+	}
+
+	refund, err := domain.NewRefund(order, payment, ledger, domain.RefundRequest{
+		Reason:  input.Reason,
+		Comment: input.Comment,
+		Actor:   input.Actor,
+		Items:   input.Items,
+	})
+	if err != nil {
+		uc.logger.Warn("Refund rejected", zap.Int64("order_id", order.ID), zap.Error(err))
+		return nil, err
+	}
+
+	refunded := ledger.Refunded()
+	refund.ID, err = uc.refunds.Create(ctx, refund, refunded)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create refund %w", err)
+	}
+
+	ref, err := uc.gateway.Refund(ctx, payment.ProviderRef, refund.Amount,
+		"refund-"+strconv.FormatInt(refund.ID, 10))
+	if err != nil {
+		uc.logger.Error("Failed to refund payment", zap.Int64("order_id", order.ID), zap.Error(err))
+		if failErr := refund.Fail(err.Error()); failErr == nil {
+			if failErr = uc.refunds.Complete(ctx, refund, nil); failErr != nil {
+				// The refund stays pending and keeps its amount reserved until resolved by support.
+				uc.logger.Error("Failed to save failed refund", zap.Int64("refund_id", refund.ID), zap.Error(failErr))
+			}
+		}
+		return nil, fmt.Errorf("Failed to refund payment %w", err)
+	}
+
+	if err := refund.Succeed(ref); err != nil {
+		return nil, err
+	}
+
+	refunded += refund.Amount
+	full := refunded == payment.Amount
+
+	event := orderRefundedEvent(ctx, uc.encoder, order, refunded, full)
+	if err := uc.complete(ctx, order, refund, event, full, input); err != nil {
+		uc.logger.Error("Failed to save refund", zap.Int64("refund_id", refund.ID), zap.Error(err))
+		return nil, fmt.Errorf("Failed to save refund %w", err)
+	}
+
+	uc.logger.Info("Order refunded",
+		zap.Int64("order_id", order.ID),
+		zap.Int64("refund_id", refund.ID),
+		zap.Int64("amount", refund.Amount),
+		zap.Bool("full", full))
+
+	return &RefundOrderOutput{
+		Refund:     refund,
+		Refunded:   refunded,
+		Refundable: payment.Amount - refunded,
+	}, nil
+}
+
+// complete saves the succeeded refund, a full one together with the Refunded
+// status of its order. An order changed in the meantime, e.g. picked up, is
+// reloaded and the status change retried.
+func (uc *RefundOrderUseCase) complete(ctx context.Context, order *domain.Order, refund *domain.Refund,
+	event domain.RefundEventFactory, full bool, input RefundOrderInput) error {
+
+	for attempt := 1; ; attempt++ {
+		var changes []domain.StatusChange
+		if full {
+			from := order.Status
+			if err := order.Transition(domain.OrderRefunded); err != nil {
+				// The money is returned already, the refund is saved anyway.
+				uc.logger.Error("Fully refunded order can not be marked as refunded",
+					zap.Int64("order_id", order.ID), zap.Error(err))
+			} else {
+				changes = append(changes, domain.StatusChange{
+					OrderID:   order.ID,
+					From:      from,
+					To:        order.Status,
+					Actor:     input.Actor,
+					Reason:    string(input.Reason),
+					ChangedAt: order.UpdatedAt,
+				})
+			}
+		}
+
+		err := uc.refunds.Complete(ctx, refund, event, changes...)
+		if !errors.Is(err, domain.ErrOrderStatusConflict) || attempt == maxRefundCompleteAttempts {
+			return err
+		}
+
+		order, err = uc.orders.GetByID(ctx, order.ID)
+		if err != nil {
+			return fmt.Errorf("Failed to get order %w", err)
+		}
+	}
+}
+
+func orderRefundedEvent(ctx context.Context, encoder *events.Encoder, order *domain.Order,
+	refunded int64, full bool) domain.RefundEventFactory {
+	return func(refund *domain.Refund) (*domain.OutboxMessage, error) {
+		items := make([]*eventspb.RefundedItem, 0, len(refund.Items))
+		for _, item := range refund.Items {
+			items = append(items, &eventspb.RefundedItem{
+				ProductId: item.ProductID,
+				Quantity:  item.Quantity,
+				Amount:    item.Amount,
+			})
+		}
+
+		env, err := events.New(ctx, &eventspb.OrderRefunded{
+			OrderId:       order.ID,
+			UserId:        order.UserID,
+			RefundId:      refund.ID,
+			PaymentId:     refund.PaymentID,
+			Amount:        refund.Amount,
+			Reason:        string(refund.Reason),
+			Items:         items,
+			RefundedTotal: refunded,
+			Full:          full,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		payload, err := encoder.Encode(env)
+		if err != nil {
+			return nil, err
+		}
+
+		return &domain.OutboxMessage{
+			EventType:   domain.EventOrderRefunded,
+			Key:         strconv.FormatInt(order.ID, 10),
+			Payload:     payload,
+			ContentType: encoder.ContentType(),
+		}, nil
+	}
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"go.uber.org/zap"
+)
+
+// ReleaseScheduledUseCase hands scheduled orders over to the kitchen once their
+// release time has come: the order moves into Created and OrderCreated is
+// written to the outbox.
+type ReleaseScheduledUseCase struct {
+	repo    domain.OrderRepository
+	logger  *zap.Logger
+	encoder *events.Encoder
+}
+
+func NewReleaseScheduledUseCase(repo domain.OrderRepository, logger *zap.Logger,
+	encoder *events.Encoder) *ReleaseScheduledUseCase {
+	return &ReleaseScheduledUseCase{
+		repo:    repo,
+		logger:  logger,
+		encoder: encoder,
+	}
+}
+
+// Exec releases up to limit orders due at now and returns how many were released.
+func (uc *ReleaseScheduledUseCase) Exec(ctx context.Context, now time.Time, limit int) (int, error) {
+	ids, err := uc.repo.DueScheduled(ctx, now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to get due scheduled orders %w", err)
+	}
+
+	released := 0
+	for _, id := range ids {
+		err := uc.release(ctx, id)
+		if errors.Is(err, domain.ErrOrderStatusConflict) || errors.Is(err, domain.ErrInvalidTransition) {
+			// Cancelled or released by another instance in the meantime.
+			continue
+		}
+		if err != nil {
+			return released, err
+		}
+		released++
+	}
+
+	return released, nil
+}
+
+func (uc *ReleaseScheduledUseCase) release(ctx context.Context, orderID int64) error {
+	order, err := uc.repo.GetByID(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("Failed to get order %w", err)
+	}
+
+	from := order.Status
+	if err := order.Transition(domain.OrderCreated); err != nil {
+		return err
+	}
+
+	err = uc.repo.Release(ctx, order, domain.StatusChange{
+		OrderID:   order.ID,
+		From:      from,
+		To:        order.Status,
+		Actor:     domain.ActorSystem,
+		Reason:    "scheduled for " + order.ScheduledFor.Format(time.RFC3339),
+		ChangedAt: order.UpdatedAt,
+	}, orderCreatedEvent(ctx, uc.encoder))
+	if err != nil {
+		uc.logger.Error("Failed to release scheduled order", zap.Int64("order_id", order.ID), zap.Error(err))
+		return fmt.Errorf("Failed to release scheduled order %w", err)
+	}
+
+	uc.logger.Info("Released scheduled order",
+		zap.Int64("order_id", order.ID),
+		zap.Time("scheduled_for", order.ScheduledFor))
+	return nil
+}
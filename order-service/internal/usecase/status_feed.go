@@ -0,0 +1,69 @@
+package usecase
+
+import "sync"
+
+// StatusFeed wakes up watchers of order statuses. Wake ups carry no data and
+// coalesce, a watcher rereads the history of the order, so a slow one never
+// blocks the feed or misses a change.
+type StatusFeed struct {
+	mu       sync.Mutex
+	watchers map[int64]map[chan struct{}]struct{}
+}
+
+func NewStatusFeed() *StatusFeed {
+	return &StatusFeed{
+		watchers: make(map[int64]map[chan struct{}]struct{}),
+	}
+}
+
+// Subscribe returns a channel that receives a value after status changes of
+// the order and a function that stops the subscription.
+func (f *StatusFeed) Subscribe(orderID int64) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.watchers[orderID] == nil {
+		f.watchers[orderID] = make(map[chan struct{}]struct{})
+	}
+	f.watchers[orderID][ch] = struct{}{}
+
+	return ch, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		delete(f.watchers[orderID], ch)
+		if len(f.watchers[orderID]) == 0 {
+			delete(f.watchers, orderID)
+		}
+	}
+}
+
+func (f *StatusFeed) Publish(orderID int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for ch := range f.watchers[orderID] {
+		wake(ch)
+	}
+}
+
+func (f *StatusFeed) Broadcast() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, watchers := range f.watchers {
+		for ch := range watchers {
+			wake(ch)
+		}
+	}
+}
+
+// wake signals ch unless a signal is pending already.
+func wake(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
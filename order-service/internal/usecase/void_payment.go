@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"go.uber.org/zap"
+)
+
+// VoidPaymentUseCase compensates the authorize_payment saga step: the held
+// money is released and the result is replied through the outbox. A missing,
+// failed or voided payment needs nothing, a captured one can not be voided.
+type VoidPaymentUseCase struct {
+	payments domain.PaymentRepository
+	outbox   domain.OutboxRepository
+	gateway  domain.PaymentGateway
+	logger   *zap.Logger
+	encoder  *events.Encoder
+}
+
+func NewVoidPaymentUseCase(payments domain.PaymentRepository, outbox domain.OutboxRepository,
+	gateway domain.PaymentGateway, logger *zap.Logger, encoder *events.Encoder) *VoidPaymentUseCase {
+	return &VoidPaymentUseCase{
+		payments: payments,
+		outbox:   outbox,
+		gateway:  gateway,
+		logger:   logger,
+		encoder:  encoder,
+	}
+}
+
+func (uc *VoidPaymentUseCase) Exec(ctx context.Context, orderID int64) error {
+	payment, err := uc.payments.GetByOrderID(ctx, orderID)
+	if errors.Is(err, domain.ErrPaymentNotFound) {
+		return uc.reply(ctx, &domain.Payment{OrderID: orderID}, "")
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to get payment %w", err)
+	}
+
+	from := payment.Status
+	switch payment.Status {
+	case domain.PaymentPending:
+		// Authorization never finished, make sure a late one does not hold money.
+		if err := payment.Fail("voided before authorization"); err != nil {
+			return err
+		}
+		err = uc.payments.Update(ctx, payment, from,
+			paymentFailedEvent(ctx, uc.encoder), voidReplyEvent(ctx, uc.encoder, ""))
+
+	case domain.PaymentAuthorized:
+		if err := payment.Void(); err != nil {
+			return err
+		}
+		if err := uc.gateway.Void(ctx, payment.ProviderRef); err != nil {
+			uc.logger.Error("Failed to void payment", zap.Int64("order_id", orderID), zap.Error(err))
+			return fmt.Errorf("Failed to void payment %w", err)
+		}
+		err = uc.payments.Update(ctx, payment, from, voidReplyEvent(ctx, uc.encoder, ""))
+
+	case domain.PaymentCaptured:
+		uc.logger.Warn("Captured payment can not be voided", zap.Int64("order_id", orderID))
+		return uc.reply(ctx, payment, "payment is already captured")
+
+	default:
+		return uc.reply(ctx, payment, "")
+	}
+
+	if err != nil {
+		uc.logger.Error("Failed to save voided payment", zap.Int64("order_id", orderID), zap.Error(err))
+		return fmt.Errorf("Failed to save voided payment %w", err)
+	}
+
+	uc.logger.Info("Payment voided",
+		zap.Int64("order_id", orderID),
+		zap.String("status", string(payment.Status)))
+	return nil
+}
+
+// reply reports the compensation result without changing the payment.
+func (uc *VoidPaymentUseCase) reply(ctx context.Context, payment *domain.Payment, failure string) error {
+	msg, err := voidReplyEvent(ctx, uc.encoder, failure)(payment)
+	if err != nil {
+		return fmt.Errorf("Failed to build saga reply %w", err)
+	}
+
+	if err := uc.outbox.Enqueue(ctx, msg); err != nil {
+		return fmt.Errorf("Failed to enqueue saga reply %w", err)
+	}
+
+	return nil
+}
+
+// voidReplyEvent replies to the authorize_payment compensation, an empty
+// failure means success.
+func voidReplyEvent(ctx context.Context, encoder *events.Encoder, failure string) domain.PaymentEventFactory {
+	return func(payment *domain.Payment) (*domain.OutboxMessage, error) {
+		return paymentOutboxMessage(ctx, encoder, domain.EventSagaReply, payment, &eventspb.SagaReply{
+			OrderId:    payment.OrderID,
+			Step:       string(domain.SagaAuthorizePayment),
+			Compensate: true,
+			Success:    failure == "",
+			Reason:     failure,
+			Reference:  payment.ProviderRef,
+		})
+	}
+}
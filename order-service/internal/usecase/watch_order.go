@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/order-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+type WatchOrderConfig struct {
+	// PollInterval rereads the history without a wake up, in case a
+	// notification was lost, e.g. while the listener reconnects.
+	PollInterval time.Duration
+}
+
+type WatchOrderInput struct {
+	OrderID int64
+	// AfterVersion is the last version the watcher has seen, zero sends the whole history.
+	AfterVersion int64
+}
+
+// OrderUpdate is a status change of a watched order. Version is the position
+// of the change in the order history, starting from 1.
+type OrderUpdate struct {
+	Version int64
+	Change  domain.StatusChange
+	// ETA is the expected delivery time, zero once the order is delivered or closed.
+	ETA time.Time
+}
+
+// WatchOrderUseCase streams status changes of an order. Any number of
+// watchers may follow the same order, each reads the history on its own and
+// resumes after the version it has seen.
+type WatchOrderUseCase struct {
+	repo   domain.OrderRepository
+	feed   *StatusFeed
+	prep   PrepTimer
+	cfg    WatchOrderConfig
+	logger *zap.Logger
+}
+
+func NewWatchOrderUseCase(repo domain.OrderRepository, feed *StatusFeed, prep PrepTimer,
+	cfg WatchOrderConfig, logger *zap.Logger) *WatchOrderUseCase {
+	return &WatchOrderUseCase{
+		repo:   repo,
+		feed:   feed,
+		prep:   prep,
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// Exec calls send for every change after input.AfterVersion until the order
+// is delivered or closed, send fails or ctx is done.
+func (uc *WatchOrderUseCase) Exec(ctx context.Context, input WatchOrderInput, send func(OrderUpdate) error) error {
+	// Subscribe before the first read, a change in between only wakes us up once more.
+	wakeups, stop := uc.feed.Subscribe(input.OrderID)
+	defer stop()
+
+	order, err := uc.repo.GetByID(ctx, input.OrderID)
+	if err != nil {
+		return fmt.Errorf("Failed to get order %w", err)
+	}
+
+	prepTime, err := uc.prep.PrepTime(ctx, order.RestaurantID)
+	if err != nil {
+		// The ETA is less accurate, the status is still worth watching.
+		uc.logger.Warn("Failed to get prep time for ETA", zap.Int64("order_id", order.ID), zap.Error(err))
+	}
+
+	ticker := time.NewTicker(uc.cfg.PollInterval)
+	defer ticker.Stop()
+
+	version := input.AfterVersion
+	for {
+		history, err := uc.repo.History(ctx, order.ID)
+		if err != nil {
+			return fmt.Errorf("Failed to get order history %w", err)
+		}
+
+		for ; version < int64(len(history)); version++ {
+			change := history[version]
+			err := send(OrderUpdate{
+				Version: version + 1,
+				Change:  change,
+				ETA:     domain.EstimateDelivery(order, change, prepTime),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(history) > 0 && history[len(history)-1].To.IsTerminal() && version >= int64(len(history)) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wakeups:
+		case <-ticker.C:
+		}
+	}
+}
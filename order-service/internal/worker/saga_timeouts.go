@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	sagaTimeouts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "order_saga_timeouts_total",
+		Help: "Number of order saga steps and compensations that timed out.",
+	})
+	sagaTimeoutErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "order_saga_timeout_errors_total",
+		Help: "Number of failed attempts to handle order saga timeouts.",
+	})
+)
+
+// SagaExpirer handles up to limit sagas whose deadline passed at now.
+type SagaExpirer interface {
+	ExpireDue(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+type SagaTimeoutsConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// SagaTimeouts drives order sagas whose participants did not reply in time.
+// Deadlines live in Postgres, so a restart only delays them until the next poll.
+type SagaTimeouts struct {
+	expirer SagaExpirer
+	cfg     SagaTimeoutsConfig
+	logger  *zap.Logger
+}
+
+func NewSagaTimeouts(expirer SagaExpirer, cfg SagaTimeoutsConfig, logger *zap.Logger) *SagaTimeouts {
+	return &SagaTimeouts{
+		expirer: expirer,
+		cfg:     cfg,
+		logger:  logger.Named("saga_timeouts"),
+	}
+}
+
+func (s *SagaTimeouts) Run(ctx context.Context) {
+	s.logger.Info("Saga timeouts worker has been started")
+
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for s.expire(ctx) == s.cfg.BatchSize {
+			if ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Saga timeouts worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SagaTimeouts) expire(ctx context.Context) int {
+	expired, err := s.expirer.ExpireDue(ctx, time.Now(), s.cfg.BatchSize)
+	sagaTimeouts.Add(float64(expired))
+	if err != nil {
+		if ctx.Err() == nil {
+			sagaTimeoutErrors.Inc()
+			s.logger.Error("Failed to handle saga timeouts", zap.Int("expired", expired), zap.Error(err))
+		}
+		return 0
+	}
+
+	return expired
+}
@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	scheduledReleased = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "order_scheduled_released_total",
+		Help: "Number of scheduled orders released to the kitchen.",
+	})
+	scheduledReleaseErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "order_scheduled_release_errors_total",
+		Help: "Number of failed scheduled order release attempts.",
+	})
+)
+
+// ScheduledReleaser releases up to limit scheduled orders due at now.
+type ScheduledReleaser interface {
+	Exec(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+type SchedulerConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// Scheduler releases scheduled orders to the kitchen at their release time.
+// All state lives in Postgres, so orders due during a restart are released
+// on the first poll after it.
+type Scheduler struct {
+	releaser ScheduledReleaser
+	cfg      SchedulerConfig
+	logger   *zap.Logger
+}
+
+func NewScheduler(releaser ScheduledReleaser, cfg SchedulerConfig, logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		releaser: releaser,
+		cfg:      cfg,
+		logger:   logger.Named("scheduler"),
+	}
+}
+
+func (s *Scheduler) Run(ctx context.Context) {
+	s.logger.Info("Order scheduler has been started")
+
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for s.release(ctx) == s.cfg.BatchSize {
+			if ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Order scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) release(ctx context.Context) int {
+	released, err := s.releaser.Exec(ctx, time.Now(), s.cfg.BatchSize)
+	scheduledReleased.Add(float64(released))
+	if err != nil {
+		if ctx.Err() == nil {
+			scheduledReleaseErrors.Inc()
+			s.logger.Error("Failed to release scheduled orders", zap.Int("released", released), zap.Error(err))
+		}
+		return 0
+	}
+
+	return released
+}
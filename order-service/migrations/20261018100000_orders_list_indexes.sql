@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_orders_user_id_id ON orders (user_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_orders_restaurant_id_id ON orders (restaurant_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_orders_status_id ON orders (status, id DESC);
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON orders (created_at);
+CREATE INDEX IF NOT EXISTS idx_orders_items_order_id ON orders_items (order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_items_order_id;
+DROP INDEX IF EXISTS idx_orders_created_at;
+DROP INDEX IF EXISTS idx_orders_status_id;
+DROP INDEX IF EXISTS idx_orders_restaurant_id_id;
+DROP INDEX IF EXISTS idx_orders_user_id_id;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS total BIGINT NOT NULL DEFAULT 0;
+
+UPDATE orders o
+SET total = i.total
+FROM (
+    SELECT order_id, SUM(price * quantity) AS total
+    FROM orders_items
+    GROUP BY order_id
+) i
+WHERE o.id = i.order_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders DROP COLUMN IF EXISTS total;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS idempotency_key TEXT;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS request_hash TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_orders_user_id_idempotency_key
+    ON orders (user_id, idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uq_orders_user_id_idempotency_key;
+ALTER TABLE orders DROP COLUMN IF EXISTS request_hash;
+ALTER TABLE orders DROP COLUMN IF EXISTS idempotency_key;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS delivery_street TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS delivery_apartment TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS delivery_city TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS delivery_lat DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS delivery_lng DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS delivery_comment TEXT NOT NULL DEFAULT '';
+
+-- Orders created before structured addresses keep the single line as street.
+UPDATE orders SET delivery_street = delivery_address WHERE delivery_street = '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders DROP COLUMN IF EXISTS delivery_comment;
+ALTER TABLE orders DROP COLUMN IF EXISTS delivery_lng;
+ALTER TABLE orders DROP COLUMN IF EXISTS delivery_lat;
+ALTER TABLE orders DROP COLUMN IF EXISTS delivery_city;
+ALTER TABLE orders DROP COLUMN IF EXISTS delivery_apartment;
+ALTER TABLE orders DROP COLUMN IF EXISTS delivery_street;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS scheduled_for TIMESTAMPTZ;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS release_at TIMESTAMPTZ;
+
+-- Scheduler polls orders waiting for release.
+CREATE INDEX IF NOT EXISTS idx_orders_release_at ON orders (release_at) WHERE status = 'Scheduled';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_release_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS release_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS scheduled_for;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_sagas (
+    order_id BIGINT PRIMARY KEY REFERENCES orders(id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    step TEXT NOT NULL,
+    pending TEXT[] NOT NULL DEFAULT '{}',
+    failure_reason TEXT NOT NULL DEFAULT '',
+    attempts INT NOT NULL DEFAULT 0,
+    deadline_at TIMESTAMPTZ,
+    version BIGINT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_sagas_deadline_at ON order_sagas (deadline_at)
+    WHERE status IN ('Running', 'Compensating');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS order_sagas;
+-- +goose StatementEnd
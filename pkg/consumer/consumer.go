@@ -0,0 +1,265 @@
+// Package consumer reads Kafka topics with bounded retries, a dead-letter
+// topic and an optional partition-aware worker pool.
+package consumer
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+type metrics struct {
+	retried      *prometheus.CounterVec
+	deadLettered *prometheus.CounterVec
+}
+
+var (
+	metricsMu sync.Mutex
+	// registered holds metrics per namespace, consumers of a service share them.
+	registered = make(map[string]*metrics)
+)
+
+func metricsFor(namespace string) *metrics {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+
+	if m, ok := registered[namespace]; ok {
+		return m
+	}
+
+	m := &metrics{
+		retried: promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "messages_retried_total",
+			Help:      "Number of handler retries of Kafka messages.",
+		}, []string{"topic"}),
+		deadLettered: promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "messages_dead_lettered_total",
+			Help:      "Number of Kafka messages sent to the dead-letter topic.",
+		}, []string{"topic", "reason"}),
+	}
+	registered[namespace] = m
+	return m
+}
+
+// Headers added to dead-lettered messages.
+const (
+	HeaderError             = "x-error"
+	HeaderAttempts          = "x-attempts"
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderFailedAt          = "x-failed-at"
+)
+
+type MessageHandler interface {
+	Handle(ctx context.Context, msg kafka.Message) error
+}
+
+type Consumer struct {
+	reader  *kafka.Reader
+	dlq     *kafka.Writer
+	retry   RetryPolicy
+	pool    PoolConfig
+	handler MessageHandler
+	metrics *metrics
+	logger  *zap.Logger
+}
+
+type Config struct {
+	Brokers []string
+	Topic   string
+	GroupID string
+	TimeOut time.Duration
+	// DLQTopic defaults to Topic + ".dlq".
+	DLQTopic string
+	Retry    RetryPolicy
+	Pool     PoolConfig
+	// MetricsNamespace prefixes the consumer metrics, e.g. "restaurant_consumer".
+	MetricsNamespace string
+}
+
+func NewConsumer(cfg Config, handler MessageHandler, logger *zap.Logger) *Consumer {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:          cfg.Brokers,
+		Topic:            cfg.Topic,
+		GroupID:          cfg.GroupID,
+		MinBytes:         10 << 13,
+		MaxBytes:         10 << 23,
+		RebalanceTimeout: cfg.TimeOut,
+	})
+
+	dlqTopic := cfg.DLQTopic
+	if dlqTopic == "" {
+		dlqTopic = cfg.Topic + ".dlq"
+	}
+
+	dlq := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Topic:        dlqTopic,
+		Balancer:     &kafka.Hash{},
+		WriteTimeout: cfg.TimeOut,
+		RequiredAcks: kafka.RequireAll,
+	}
+
+	if cfg.Retry.MaxAttempts < 1 {
+		cfg.Retry.MaxAttempts = 1
+	}
+	if cfg.Pool.QueueSize < 1 {
+		cfg.Pool.QueueSize = 1
+	}
+	if cfg.Pool.CommitInterval <= 0 {
+		cfg.Pool.CommitInterval = time.Second
+	}
+
+	return &Consumer{
+		reader:  reader,
+		dlq:     dlq,
+		retry:   cfg.Retry,
+		pool:    cfg.Pool,
+		handler: handler,
+		metrics: metricsFor(cfg.MetricsNamespace),
+		logger:  logger,
+	}
+}
+
+func (c *Consumer) Run(ctx context.Context) {
+	if c.pool.Workers > 1 {
+		c.runPool(ctx)
+		return
+	}
+
+	c.logger.Info("Consumer has been started")
+	for {
+		message, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				c.logger.Info("Context cancled or exceeded")
+				break
+			}
+			c.logger.Error("Failed to read message", zap.Error(err))
+			continue
+		}
+		c.logger.Info("Message recieved", zap.ByteString("value", message.Value))
+
+		if err := c.process(ctx, message); err != nil {
+			// Context is cancelled, the message is not committed and will be redelivered.
+			c.logger.Info("Stopped processing message", zap.Int64("offset", message.Offset), zap.Error(err))
+			break
+		}
+
+		if err := c.reader.CommitMessages(ctx, message); err != nil {
+			c.logger.Error("Failed to commit message", zap.Error(err))
+		}
+	}
+}
+
+// process handles the message with retries and dead-letters it when retries
+// are exhausted or the error is permanent. It only fails if ctx is done.
+func (c *Consumer) process(ctx context.Context, message kafka.Message) error {
+	var (
+		err     error
+		attempt int
+	)
+	for attempt = 1; attempt <= c.retry.MaxAttempts; attempt++ {
+		if err = c.handler.Handle(ctx, message); err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if IsPermanent(err) || attempt == c.retry.MaxAttempts {
+			break
+		}
+
+		c.metrics.retried.WithLabelValues(message.Topic).Inc()
+		c.logger.Warn("Failed to handle message, retrying",
+			zap.Int("attempt", attempt),
+			zap.Int64("offset", message.Offset),
+			zap.Error(err))
+
+		if err := sleep(ctx, c.retry.Backoff(attempt)); err != nil {
+			return err
+		}
+	}
+
+	if attempt > c.retry.MaxAttempts {
+		attempt = c.retry.MaxAttempts
+	}
+	return c.deadLetter(ctx, message, err, attempt)
+}
+
+// deadLetter keeps trying to write the message to DLQ: committing the offset
+// without it would lose the message.
+func (c *Consumer) deadLetter(ctx context.Context, message kafka.Message, cause error, attempts int) error {
+	reason := "exhausted"
+	if IsPermanent(cause) {
+		reason = "permanent"
+	}
+
+	c.logger.Error("Sending message to DLQ",
+		zap.String("reason", reason),
+		zap.Int("attempts", attempts),
+		zap.Int64("offset", message.Offset),
+		zap.Error(cause))
+
+	headers := append(slices.Clone(message.Headers),
+		kafka.Header{Key: HeaderError, Value: []byte(cause.Error())},
+		kafka.Header{Key: HeaderAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: HeaderOriginalTopic, Value: []byte(message.Topic)},
+		kafka.Header{Key: HeaderOriginalPartition, Value: []byte(strconv.Itoa(message.Partition))},
+		kafka.Header{Key: HeaderOriginalOffset, Value: []byte(strconv.FormatInt(message.Offset, 10))},
+		kafka.Header{Key: HeaderFailedAt, Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+	)
+
+	dlqMessage := kafka.Message{
+		Key:     message.Key,
+		Value:   message.Value,
+		Headers: headers,
+	}
+
+	for retry := 1; ; retry++ {
+		err := c.dlq.WriteMessages(ctx, dlqMessage)
+		if err == nil {
+			c.metrics.deadLettered.WithLabelValues(message.Topic, reason).Inc()
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		c.logger.Error("Failed to write message to DLQ", zap.Int("retry", retry), zap.Error(err))
+		if err := sleep(ctx, c.retry.Backoff(retry)); err != nil {
+			return err
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (c *Consumer) Close() error {
+	c.logger.Info("Closing reader")
+	if err := c.dlq.Close(); err != nil {
+		c.logger.Warn("Failed to close DLQ writer", zap.Error(err))
+	}
+	return c.reader.Close()
+}
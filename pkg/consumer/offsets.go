@@ -0,0 +1,87 @@
+package consumer
+
+import (
+	"sync"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// offsetTracker tracks in-flight messages per partition. Messages can finish
+// out of order, but only the offset below which everything is done may be committed.
+type offsetTracker struct {
+	mu         sync.Mutex
+	partitions map[int]*partitionOffsets
+}
+
+type partitionOffsets struct {
+	// inflight holds fetched offsets in fetch order, they grow monotonically.
+	inflight []int64
+	done     map[int64]bool
+	// committable is the last message that has all previous ones done.
+	committable *kafka.Message
+	committed   int64
+}
+
+func newOffsetTracker() *offsetTracker {
+	return &offsetTracker{
+		partitions: make(map[int]*partitionOffsets),
+	}
+}
+
+func (t *offsetTracker) add(msg kafka.Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.partitions[msg.Partition]
+	if !ok {
+		p = &partitionOffsets{done: make(map[int64]bool), committed: -1}
+		t.partitions[msg.Partition] = p
+	}
+	p.inflight = append(p.inflight, msg.Offset)
+}
+
+func (t *offsetTracker) markDone(msg kafka.Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.partitions[msg.Partition]
+	if !ok {
+		return
+	}
+	p.done[msg.Offset] = true
+
+	last := int64(-1)
+	for len(p.inflight) > 0 && p.done[p.inflight[0]] {
+		last = p.inflight[0]
+		delete(p.done, last)
+		p.inflight = p.inflight[1:]
+	}
+	if last >= 0 {
+		p.committable = &kafka.Message{Topic: msg.Topic, Partition: msg.Partition, Offset: last}
+	}
+}
+
+// pending returns messages to commit, one per partition, that were not committed yet.
+func (t *offsetTracker) pending() []kafka.Message {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	msgs := make([]kafka.Message, 0, len(t.partitions))
+	for _, p := range t.partitions {
+		if p.committable != nil && p.committable.Offset > p.committed {
+			msgs = append(msgs, *p.committable)
+		}
+	}
+	return msgs
+}
+
+func (t *offsetTracker) committed(msgs []kafka.Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, msg := range msgs {
+		if p, ok := t.partitions[msg.Partition]; ok && msg.Offset > p.committed {
+			p.committed = msg.Offset
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+type PoolConfig struct {
+	// Workers > 1 enables parallel processing. Messages with the same key always go
+	// to the same worker, so per-key (per order) ordering is preserved.
+	Workers        int
+	QueueSize      int
+	CommitInterval time.Duration
+	// DrainTimeout bounds how long in-flight messages may run after shutdown starts.
+	DrainTimeout time.Duration
+}
+
+func (c *Consumer) runPool(ctx context.Context) {
+	c.logger.Info("Consumer has been started in worker pool mode", zap.Int("workers", c.pool.Workers))
+
+	// In-flight messages are finished even after ctx is cancelled, but not longer than DrainTimeout.
+	processCtx, cancelProcess := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelProcess()
+	stopDrain := context.AfterFunc(ctx, func() {
+		time.AfterFunc(c.pool.DrainTimeout, cancelProcess)
+	})
+	defer stopDrain()
+
+	tracker := newOffsetTracker()
+
+	queues := make([]chan kafka.Message, c.pool.Workers)
+	var workers sync.WaitGroup
+	for i := range queues {
+		queues[i] = make(chan kafka.Message, c.pool.QueueSize)
+		workers.Add(1)
+		go func(queue <-chan kafka.Message) {
+			defer workers.Done()
+			for message := range queue {
+				if err := c.process(processCtx, message); err != nil {
+					// Not marked as done, will be redelivered after restart.
+					c.logger.Warn("Stopped processing message", zap.Int64("offset", message.Offset), zap.Error(err))
+					continue
+				}
+				tracker.markDone(message)
+			}
+		}(queues[i])
+	}
+
+	committerDone := make(chan struct{})
+	go func() {
+		defer close(committerDone)
+		ticker := time.NewTicker(c.pool.CommitInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.commit(ctx, tracker)
+			}
+		}
+	}()
+
+	for {
+		message, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				break
+			}
+			c.logger.Error("Failed to read message", zap.Error(err))
+			continue
+		}
+
+		tracker.add(message)
+		queues[workerIndex(message, len(queues))] <- message
+	}
+
+	c.logger.Info("Draining in-flight messages")
+	for _, queue := range queues {
+		close(queue)
+	}
+	workers.Wait()
+	<-committerDone
+
+	commitCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c.commit(commitCtx, tracker)
+
+	c.logger.Info("Consumer stopped")
+}
+
+func (c *Consumer) commit(ctx context.Context, tracker *offsetTracker) {
+	msgs := tracker.pending()
+	if len(msgs) == 0 {
+		return
+	}
+
+	if err := c.reader.CommitMessages(ctx, msgs...); err != nil {
+		c.logger.Error("Failed to commit messages", zap.Error(err))
+		return
+	}
+	tracker.committed(msgs)
+}
+
+func workerIndex(message kafka.Message, workers int) int {
+	h := fnv.New32a()
+	if len(message.Key) > 0 {
+		h.Write(message.Key)
+	} else {
+		h.Write([]byte{byte(message.Partition >> 8), byte(message.Partition)})
+	}
+	return int(h.Sum32() % uint32(workers))
+}
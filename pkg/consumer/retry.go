@@ -0,0 +1,55 @@
+package consumer
+
+import (
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// PermanentError marks a handler error that will not go away on retry,
+// e.g. a malformed payload. Such messages go straight to the dead-letter topic.
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return "permanent: " + e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{Err: err}
+}
+
+func IsPermanent(err error) bool {
+	var permanent *PermanentError
+	return errors.As(err, &permanent)
+}
+
+type RetryPolicy struct {
+	// MaxAttempts is the total number of handler calls, including the first one.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff returns the delay before the given retry (1-based), doubled on every
+// attempt, capped by MaxBackoff and jittered by up to 20%.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	jitter := time.Duration(rand.Int64N(int64(delay)/5 + 1))
+	return delay - jitter
+}
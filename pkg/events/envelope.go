@@ -0,0 +1,168 @@
+// Package events holds the envelope codec for events exchanged between
+// services through Kafka. Event messages are defined in api/proto/events/v1.
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	TypeOrderCreated          = "order.created"
+	TypeOrderCancelled        = "order.cancelled"
+	TypeKitchenStatusChanged  = "kitchen.status_changed"
+	TypePaymentSucceeded      = "payment.succeeded"
+	TypePaymentFailed         = "payment.failed"
+	TypeSagaCommand           = "saga.command"
+	TypeSagaReply             = "saga.reply"
+	TypeOrderRefunded         = "order.refunded"
+	TypeDeliveryStatusChanged = "delivery.status_changed"
+)
+
+// Version of the contract produced by this build. Consumers accept any minor
+// version of the same major and reject other majors.
+const (
+	MajorVersion = 1
+	MinorVersion = 5
+)
+
+// HeaderContentType is the Kafka header carrying the envelope encoding.
+const HeaderContentType = "content-type"
+
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported event version")
+	ErrUnknownContentType = errors.New("unknown event content type")
+	ErrUnknownPayload     = errors.New("unknown event payload")
+)
+
+type Format string
+
+const (
+	FormatJSON     Format = "json"
+	FormatProtobuf Format = "protobuf"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch Format(s) {
+	case FormatJSON, "":
+		return FormatJSON, nil
+	case FormatProtobuf:
+		return FormatProtobuf, nil
+	default:
+		return "", fmt.Errorf("unknown event format %q", s)
+	}
+}
+
+func (f Format) ContentType() string {
+	if f == FormatProtobuf {
+		return ContentTypeProtobuf
+	}
+	return ContentTypeJSON
+}
+
+// New wraps payload into an envelope with a fresh event id and the trace
+// context found in ctx.
+func New(ctx context.Context, payload proto.Message) (*eventspb.EventEnvelope, error) {
+	env := &eventspb.EventEnvelope{
+		EventId: uuid.NewString(),
+		Version: &eventspb.EventVersion{
+			Major: MajorVersion,
+			Minor: MinorVersion,
+		},
+		OccurredAt: timestamppb.New(time.Now()),
+		Trace:      TraceFromContext(ctx),
+	}
+
+	switch p := payload.(type) {
+	case *eventspb.OrderCreated:
+		env.Type = TypeOrderCreated
+		env.Payload = &eventspb.EventEnvelope_OrderCreated{OrderCreated: p}
+	case *eventspb.OrderCancelled:
+		env.Type = TypeOrderCancelled
+		env.Payload = &eventspb.EventEnvelope_OrderCancelled{OrderCancelled: p}
+	case *eventspb.KitchenStatusChanged:
+		env.Type = TypeKitchenStatusChanged
+		env.Payload = &eventspb.EventEnvelope_KitchenStatusChanged{KitchenStatusChanged: p}
+	case *eventspb.PaymentSucceeded:
+		env.Type = TypePaymentSucceeded
+		env.Payload = &eventspb.EventEnvelope_PaymentSucceeded{PaymentSucceeded: p}
+	case *eventspb.PaymentFailed:
+		env.Type = TypePaymentFailed
+		env.Payload = &eventspb.EventEnvelope_PaymentFailed{PaymentFailed: p}
+	case *eventspb.SagaCommand:
+		env.Type = TypeSagaCommand
+		env.Payload = &eventspb.EventEnvelope_SagaCommand{SagaCommand: p}
+	case *eventspb.SagaReply:
+		env.Type = TypeSagaReply
+		env.Payload = &eventspb.EventEnvelope_SagaReply{SagaReply: p}
+	case *eventspb.OrderRefunded:
+		env.Type = TypeOrderRefunded
+		env.Payload = &eventspb.EventEnvelope_OrderRefunded{OrderRefunded: p}
+	case *eventspb.DeliveryStatusChanged:
+		env.Type = TypeDeliveryStatusChanged
+		env.Payload = &eventspb.EventEnvelope_DeliveryStatusChanged{DeliveryStatusChanged: p}
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnknownPayload, payload)
+	}
+
+	return env, nil
+}
+
+type Encoder struct {
+	format Format
+}
+
+func NewEncoder(format Format) *Encoder {
+	return &Encoder{format: format}
+}
+
+// ContentType is the value for HeaderContentType of encoded messages.
+func (e *Encoder) ContentType() string {
+	return e.format.ContentType()
+}
+
+func (e *Encoder) Encode(env *eventspb.EventEnvelope) ([]byte, error) {
+	if e.format == FormatProtobuf {
+		return proto.Marshal(env)
+	}
+	return protojson.Marshal(env)
+}
+
+// Decode parses an envelope encoded as contentType. Unknown fields are
+// ignored, so newer minor versions stay readable; other majors are rejected
+// with ErrUnsupportedVersion.
+func Decode(contentType string, data []byte) (*eventspb.EventEnvelope, error) {
+	env := &eventspb.EventEnvelope{}
+
+	var err error
+	switch contentType {
+	case ContentTypeJSON:
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, env)
+	case ContentTypeProtobuf:
+		err = proto.Unmarshal(data, env)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownContentType, contentType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode event envelope: %w", err)
+	}
+
+	if env.GetVersion().GetMajor() != MajorVersion {
+		return nil, fmt.Errorf("%w: %s v%d", ErrUnsupportedVersion, env.Type, env.GetVersion().GetMajor())
+	}
+
+	return env, nil
+}
@@ -0,0 +1,25 @@
+package events
+
+import eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+
+// Steps of the order saga, in execution order.
+const (
+	StepReserveItems     = "reserve_items"
+	StepAuthorizePayment = "authorize_payment"
+	StepConfirmTicket    = "confirm_ticket"
+)
+
+// Reply builds the reply to cmd, a nil err means success.
+func Reply(cmd *eventspb.SagaCommand, reference string, err error) *eventspb.SagaReply {
+	reply := &eventspb.SagaReply{
+		OrderId:    cmd.OrderId,
+		Step:       cmd.Step,
+		Compensate: cmd.Compensate,
+		Success:    err == nil,
+		Reference:  reference,
+	}
+	if err != nil {
+		reply.Reason = err.Error()
+	}
+	return reply
+}
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"context"
+
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+)
+
+// W3C trace context header names, used both as gRPC metadata and Kafka headers.
+const (
+	HeaderTraceparent = "traceparent"
+	HeaderTracestate  = "tracestate"
+)
+
+type traceKey struct{}
+
+// ContextWithTrace stores trace context to be copied into events created with ctx.
+func ContextWithTrace(ctx context.Context, trace *eventspb.TraceContext) context.Context {
+	if trace.GetTraceparent() == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, traceKey{}, trace)
+}
+
+func TraceFromContext(ctx context.Context) *eventspb.TraceContext {
+	trace, _ := ctx.Value(traceKey{}).(*eventspb.TraceContext)
+	return trace
+}
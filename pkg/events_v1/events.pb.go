@@ -0,0 +1,1531 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: events.proto
+
+package events_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventEnvelope wraps every event sent between services through Kafka.
+// Compatible changes bump version.minor, breaking changes bump version.major.
+type EventEnvelope struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Type       string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Version    *EventVersion          `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Trace      *TraceContext          `protobuf:"bytes,5,opt,name=trace,proto3" json:"trace,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*EventEnvelope_OrderCreated
+	//	*EventEnvelope_OrderCancelled
+	//	*EventEnvelope_KitchenStatusChanged
+	//	*EventEnvelope_PaymentSucceeded
+	//	*EventEnvelope_PaymentFailed
+	//	*EventEnvelope_SagaCommand
+	//	*EventEnvelope_SagaReply
+	//	*EventEnvelope_OrderRefunded
+	//	*EventEnvelope_DeliveryStatusChanged
+	Payload       isEventEnvelope_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventEnvelope) Reset() {
+	*x = EventEnvelope{}
+	mi := &file_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEnvelope) ProtoMessage() {}
+
+func (x *EventEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventEnvelope.ProtoReflect.Descriptor instead.
+func (*EventEnvelope) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventEnvelope) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetVersion() *EventVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetTrace() *TraceContext {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPayload() isEventEnvelope_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetOrderCreated() *OrderCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_OrderCreated); ok {
+			return x.OrderCreated
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetOrderCancelled() *OrderCancelled {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_OrderCancelled); ok {
+			return x.OrderCancelled
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetKitchenStatusChanged() *KitchenStatusChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_KitchenStatusChanged); ok {
+			return x.KitchenStatusChanged
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPaymentSucceeded() *PaymentSucceeded {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_PaymentSucceeded); ok {
+			return x.PaymentSucceeded
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPaymentFailed() *PaymentFailed {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_PaymentFailed); ok {
+			return x.PaymentFailed
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetSagaCommand() *SagaCommand {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_SagaCommand); ok {
+			return x.SagaCommand
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetSagaReply() *SagaReply {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_SagaReply); ok {
+			return x.SagaReply
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetOrderRefunded() *OrderRefunded {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_OrderRefunded); ok {
+			return x.OrderRefunded
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetDeliveryStatusChanged() *DeliveryStatusChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_DeliveryStatusChanged); ok {
+			return x.DeliveryStatusChanged
+		}
+	}
+	return nil
+}
+
+type isEventEnvelope_Payload interface {
+	isEventEnvelope_Payload()
+}
+
+type EventEnvelope_OrderCreated struct {
+	OrderCreated *OrderCreated `protobuf:"bytes,10,opt,name=order_created,json=orderCreated,proto3,oneof"`
+}
+
+type EventEnvelope_OrderCancelled struct {
+	OrderCancelled *OrderCancelled `protobuf:"bytes,11,opt,name=order_cancelled,json=orderCancelled,proto3,oneof"`
+}
+
+type EventEnvelope_KitchenStatusChanged struct {
+	KitchenStatusChanged *KitchenStatusChanged `protobuf:"bytes,12,opt,name=kitchen_status_changed,json=kitchenStatusChanged,proto3,oneof"`
+}
+
+type EventEnvelope_PaymentSucceeded struct {
+	PaymentSucceeded *PaymentSucceeded `protobuf:"bytes,13,opt,name=payment_succeeded,json=paymentSucceeded,proto3,oneof"`
+}
+
+type EventEnvelope_PaymentFailed struct {
+	PaymentFailed *PaymentFailed `protobuf:"bytes,14,opt,name=payment_failed,json=paymentFailed,proto3,oneof"`
+}
+
+type EventEnvelope_SagaCommand struct {
+	SagaCommand *SagaCommand `protobuf:"bytes,15,opt,name=saga_command,json=sagaCommand,proto3,oneof"`
+}
+
+type EventEnvelope_SagaReply struct {
+	SagaReply *SagaReply `protobuf:"bytes,16,opt,name=saga_reply,json=sagaReply,proto3,oneof"`
+}
+
+type EventEnvelope_OrderRefunded struct {
+	OrderRefunded *OrderRefunded `protobuf:"bytes,17,opt,name=order_refunded,json=orderRefunded,proto3,oneof"`
+}
+
+type EventEnvelope_DeliveryStatusChanged struct {
+	DeliveryStatusChanged *DeliveryStatusChanged `protobuf:"bytes,18,opt,name=delivery_status_changed,json=deliveryStatusChanged,proto3,oneof"`
+}
+
+func (*EventEnvelope_OrderCreated) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_OrderCancelled) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_KitchenStatusChanged) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_PaymentSucceeded) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_PaymentFailed) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_SagaCommand) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_SagaReply) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_OrderRefunded) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_DeliveryStatusChanged) isEventEnvelope_Payload() {}
+
+type EventVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Major         uint32                 `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor         uint32                 `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventVersion) Reset() {
+	*x = EventVersion{}
+	mi := &file_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventVersion) ProtoMessage() {}
+
+func (x *EventVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventVersion.ProtoReflect.Descriptor instead.
+func (*EventVersion) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventVersion) GetMajor() uint32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *EventVersion) GetMinor() uint32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+// W3C trace context of the request that caused the event.
+type TraceContext struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Traceparent   string                 `protobuf:"bytes,1,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
+	Tracestate    string                 `protobuf:"bytes,2,opt,name=tracestate,proto3" json:"tracestate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraceContext) Reset() {
+	*x = TraceContext{}
+	mi := &file_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraceContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceContext) ProtoMessage() {}
+
+func (x *TraceContext) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceContext.ProtoReflect.Descriptor instead.
+func (*TraceContext) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TraceContext) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
+func (x *TraceContext) GetTracestate() string {
+	if x != nil {
+		return x.Tracestate
+	}
+	return ""
+}
+
+type OrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type OrderCreated struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	OrderId      int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId       int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RestaurantId int64                  `protobuf:"varint,3,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Items        []*OrderItem           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Total        int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	// Single line form of address, kept for consumers of v1.0.
+	DeliveryAddress string   `protobuf:"bytes,6,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
+	Address         *Address `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderCreated) Reset() {
+	*x = OrderCreated{}
+	mi := &file_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCreated) ProtoMessage() {}
+
+func (x *OrderCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCreated.ProtoReflect.Descriptor instead.
+func (*OrderCreated) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderCreated) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderCreated) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OrderCreated) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+func (x *OrderCreated) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderCreated) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OrderCreated) GetDeliveryAddress() string {
+	if x != nil {
+		return x.DeliveryAddress
+	}
+	return ""
+}
+
+func (x *OrderCreated) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	Apartment     string                 `protobuf:"bytes,2,opt,name=apartment,proto3" json:"apartment,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Lat           float64                `protobuf:"fixed64,4,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64                `protobuf:"fixed64,5,opt,name=lng,proto3" json:"lng,omitempty"`
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetApartment() string {
+	if x != nil {
+		return x.Apartment
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *Address) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+func (x *Address) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type OrderCancelled struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RestaurantId  int64                  `protobuf:"varint,3,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCancelled) Reset() {
+	*x = OrderCancelled{}
+	mi := &file_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCancelled) ProtoMessage() {}
+
+func (x *OrderCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCancelled.ProtoReflect.Descriptor instead.
+func (*OrderCancelled) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OrderCancelled) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderCancelled) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OrderCancelled) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+func (x *OrderCancelled) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type KitchenStatusChanged struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KitchenOrderId int64                  `protobuf:"varint,1,opt,name=kitchen_order_id,json=kitchenOrderId,proto3" json:"kitchen_order_id,omitempty"`
+	OrderId        int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RestaurantId   int64                  `protobuf:"varint,3,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	// ACCEPTED, PREPARING or READY.
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitchenStatusChanged) Reset() {
+	*x = KitchenStatusChanged{}
+	mi := &file_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitchenStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitchenStatusChanged) ProtoMessage() {}
+
+func (x *KitchenStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitchenStatusChanged.ProtoReflect.Descriptor instead.
+func (*KitchenStatusChanged) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KitchenStatusChanged) GetKitchenOrderId() int64 {
+	if x != nil {
+		return x.KitchenOrderId
+	}
+	return 0
+}
+
+func (x *KitchenStatusChanged) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *KitchenStatusChanged) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+func (x *KitchenStatusChanged) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// DeliveryStatusChanged is sent by delivery-service when the courier picks the
+// order up at the restaurant or hands it over to the customer.
+type DeliveryStatusChanged struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JobId     int64                  `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	OrderId   int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CourierId int64                  `protobuf:"varint,3,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	// PICKED_UP or DELIVERED.
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryStatusChanged) Reset() {
+	*x = DeliveryStatusChanged{}
+	mi := &file_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryStatusChanged) ProtoMessage() {}
+
+func (x *DeliveryStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryStatusChanged.ProtoReflect.Descriptor instead.
+func (*DeliveryStatusChanged) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeliveryStatusChanged) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *DeliveryStatusChanged) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *DeliveryStatusChanged) GetCourierId() int64 {
+	if x != nil {
+		return x.CourierId
+	}
+	return 0
+}
+
+func (x *DeliveryStatusChanged) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeliveryStatusChanged) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// PaymentSucceeded is sent when the order total is authorized by the payment provider.
+// It is published to the payments topic for services outside order-service,
+// e.g. notifications and accounting. The order itself follows the
+// authorize_payment saga reply.
+type PaymentSucceeded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Provider      string                 `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderRef   string                 `protobuf:"bytes,6,opt,name=provider_ref,json=providerRef,proto3" json:"provider_ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentSucceeded) Reset() {
+	*x = PaymentSucceeded{}
+	mi := &file_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentSucceeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentSucceeded) ProtoMessage() {}
+
+func (x *PaymentSucceeded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentSucceeded.ProtoReflect.Descriptor instead.
+func (*PaymentSucceeded) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PaymentSucceeded) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *PaymentSucceeded) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *PaymentSucceeded) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PaymentSucceeded) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentSucceeded) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PaymentSucceeded) GetProviderRef() string {
+	if x != nil {
+		return x.ProviderRef
+	}
+	return ""
+}
+
+// PaymentFailed is sent when the payment provider declines the order total.
+// Like PaymentSucceeded it is meant for services outside order-service.
+type PaymentFailed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Provider      string                 `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentFailed) Reset() {
+	*x = PaymentFailed{}
+	mi := &file_events_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentFailed) ProtoMessage() {}
+
+func (x *PaymentFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentFailed.ProtoReflect.Descriptor instead.
+func (*PaymentFailed) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PaymentFailed) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *PaymentFailed) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *PaymentFailed) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PaymentFailed) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentFailed) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PaymentFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// SagaCommand asks a participant to run or compensate a step of the order saga.
+// Participants reply with SagaReply, commands may be delivered more than once.
+type SagaCommand struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	OrderId    int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Step       string                 `protobuf:"bytes,2,opt,name=step,proto3" json:"step,omitempty"`
+	Compensate bool                   `protobuf:"varint,3,opt,name=compensate,proto3" json:"compensate,omitempty"`
+	// Order contents, set for steps that need them.
+	Order         *OrderCreated `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SagaCommand) Reset() {
+	*x = SagaCommand{}
+	mi := &file_events_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SagaCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SagaCommand) ProtoMessage() {}
+
+func (x *SagaCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SagaCommand.ProtoReflect.Descriptor instead.
+func (*SagaCommand) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SagaCommand) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *SagaCommand) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *SagaCommand) GetCompensate() bool {
+	if x != nil {
+		return x.Compensate
+	}
+	return false
+}
+
+func (x *SagaCommand) GetOrder() *OrderCreated {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type SagaReply struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	OrderId    int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Step       string                 `protobuf:"bytes,2,opt,name=step,proto3" json:"step,omitempty"`
+	Compensate bool                   `protobuf:"varint,3,opt,name=compensate,proto3" json:"compensate,omitempty"`
+	Success    bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Why the step failed.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Id of the result on participant side, e.g. kitchen ticket id.
+	Reference     string `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SagaReply) Reset() {
+	*x = SagaReply{}
+	mi := &file_events_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SagaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SagaReply) ProtoMessage() {}
+
+func (x *SagaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SagaReply.ProtoReflect.Descriptor instead.
+func (*SagaReply) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SagaReply) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *SagaReply) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *SagaReply) GetCompensate() bool {
+	if x != nil {
+		return x.Compensate
+	}
+	return false
+}
+
+func (x *SagaReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SagaReply) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SagaReply) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+// OrderRefunded reports money returned to the customer from the captured payment.
+type OrderRefunded struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderId   int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId    int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RefundId  int64                  `protobuf:"varint,3,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	PaymentId int64                  `protobuf:"varint,4,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount    int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Reason code, e.g. "missing_item".
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Refunded dishes, empty for refunds not tied to items.
+	Items []*RefundedItem `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	// All refunds of the order including this one.
+	RefundedTotal int64 `protobuf:"varint,8,opt,name=refunded_total,json=refundedTotal,proto3" json:"refunded_total,omitempty"`
+	// Set when the whole captured amount is refunded.
+	Full          bool `protobuf:"varint,9,opt,name=full,proto3" json:"full,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderRefunded) Reset() {
+	*x = OrderRefunded{}
+	mi := &file_events_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderRefunded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderRefunded) ProtoMessage() {}
+
+func (x *OrderRefunded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderRefunded.ProtoReflect.Descriptor instead.
+func (*OrderRefunded) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OrderRefunded) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderRefunded) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OrderRefunded) GetRefundId() int64 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+func (x *OrderRefunded) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *OrderRefunded) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OrderRefunded) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetItems() []*RefundedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderRefunded) GetRefundedTotal() int64 {
+	if x != nil {
+		return x.RefundedTotal
+	}
+	return 0
+}
+
+func (x *OrderRefunded) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+type RefundedItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundedItem) Reset() {
+	*x = RefundedItem{}
+	mi := &file_events_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundedItem) ProtoMessage() {}
+
+func (x *RefundedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundedItem.ProtoReflect.Descriptor instead.
+func (*RefundedItem) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RefundedItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *RefundedItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RefundedItem) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+var File_events_proto protoreflect.FileDescriptor
+
+const file_events_proto_rawDesc = "" +
+	"\n" +
+	"\fevents.proto\x12\tevents_v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe9\x06\n" +
+	"\rEventEnvelope\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x121\n" +
+	"\aversion\x18\x03 \x01(\v2\x17.events_v1.EventVersionR\aversion\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12-\n" +
+	"\x05trace\x18\x05 \x01(\v2\x17.events_v1.TraceContextR\x05trace\x12>\n" +
+	"\rorder_created\x18\n" +
+	" \x01(\v2\x17.events_v1.OrderCreatedH\x00R\forderCreated\x12D\n" +
+	"\x0forder_cancelled\x18\v \x01(\v2\x19.events_v1.OrderCancelledH\x00R\x0eorderCancelled\x12W\n" +
+	"\x16kitchen_status_changed\x18\f \x01(\v2\x1f.events_v1.KitchenStatusChangedH\x00R\x14kitchenStatusChanged\x12J\n" +
+	"\x11payment_succeeded\x18\r \x01(\v2\x1b.events_v1.PaymentSucceededH\x00R\x10paymentSucceeded\x12A\n" +
+	"\x0epayment_failed\x18\x0e \x01(\v2\x18.events_v1.PaymentFailedH\x00R\rpaymentFailed\x12;\n" +
+	"\fsaga_command\x18\x0f \x01(\v2\x16.events_v1.SagaCommandH\x00R\vsagaCommand\x125\n" +
+	"\n" +
+	"saga_reply\x18\x10 \x01(\v2\x14.events_v1.SagaReplyH\x00R\tsagaReply\x12A\n" +
+	"\x0eorder_refunded\x18\x11 \x01(\v2\x18.events_v1.OrderRefundedH\x00R\rorderRefunded\x12Z\n" +
+	"\x17delivery_status_changed\x18\x12 \x01(\v2 .events_v1.DeliveryStatusChangedH\x00R\x15deliveryStatusChangedB\t\n" +
+	"\apayload\":\n" +
+	"\fEventVersion\x12\x14\n" +
+	"\x05major\x18\x01 \x01(\rR\x05major\x12\x14\n" +
+	"\x05minor\x18\x02 \x01(\rR\x05minor\"P\n" +
+	"\fTraceContext\x12 \n" +
+	"\vtraceparent\x18\x01 \x01(\tR\vtraceparent\x12\x1e\n" +
+	"\n" +
+	"tracestate\x18\x02 \x01(\tR\n" +
+	"tracestate\"\\\n" +
+	"\tOrderItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\"\x82\x02\n" +
+	"\fOrderCreated\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12#\n" +
+	"\rrestaurant_id\x18\x03 \x01(\x03R\frestaurantId\x12*\n" +
+	"\x05items\x18\x04 \x03(\v2\x14.events_v1.OrderItemR\x05items\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12)\n" +
+	"\x10delivery_address\x18\x06 \x01(\tR\x0fdeliveryAddress\x12,\n" +
+	"\aaddress\x18\a \x01(\v2\x12.events_v1.AddressR\aaddress\"\x91\x01\n" +
+	"\aAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x1c\n" +
+	"\tapartment\x18\x02 \x01(\tR\tapartment\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x10\n" +
+	"\x03lat\x18\x04 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x05 \x01(\x01R\x03lng\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\"\x81\x01\n" +
+	"\x0eOrderCancelled\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12#\n" +
+	"\rrestaurant_id\x18\x03 \x01(\x03R\frestaurantId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x98\x01\n" +
+	"\x14KitchenStatusChanged\x12(\n" +
+	"\x10kitchen_order_id\x18\x01 \x01(\x03R\x0ekitchenOrderId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12#\n" +
+	"\rrestaurant_id\x18\x03 \x01(\x03R\frestaurantId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"\xbb\x01\n" +
+	"\x15DeliveryStatusChanged\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x03R\x05jobId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x03 \x01(\x03R\tcourierId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\xbc\x01\n" +
+	"\x10PaymentSucceeded\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\x12!\n" +
+	"\fprovider_ref\x18\x06 \x01(\tR\vproviderRef\"\xae\x01\n" +
+	"\rPaymentFailed\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\x8b\x01\n" +
+	"\vSagaCommand\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x12\n" +
+	"\x04step\x18\x02 \x01(\tR\x04step\x12\x1e\n" +
+	"\n" +
+	"compensate\x18\x03 \x01(\bR\n" +
+	"compensate\x12-\n" +
+	"\x05order\x18\x04 \x01(\v2\x17.events_v1.OrderCreatedR\x05order\"\xaa\x01\n" +
+	"\tSagaReply\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x12\n" +
+	"\x04step\x18\x02 \x01(\tR\x04step\x12\x1e\n" +
+	"\n" +
+	"compensate\x18\x03 \x01(\bR\n" +
+	"compensate\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\"\x99\x02\n" +
+	"\rOrderRefunded\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\trefund_id\x18\x03 \x01(\x03R\brefundId\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x04 \x01(\x03R\tpaymentId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12-\n" +
+	"\x05items\x18\a \x03(\v2\x17.events_v1.RefundedItemR\x05items\x12%\n" +
+	"\x0erefunded_total\x18\b \x01(\x03R\rrefundedTotal\x12\x12\n" +
+	"\x04full\x18\t \x01(\bR\x04full\"a\n" +
+	"\fRefundedItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amountB=Z;github.com/Wuchinator/food-delivery/pkg/events_v1;events_v1b\x06proto3"
+
+var (
+	file_events_proto_rawDescOnce sync.Once
+	file_events_proto_rawDescData []byte
+)
+
+func file_events_proto_rawDescGZIP() []byte {
+	file_events_proto_rawDescOnce.Do(func() {
+		file_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_proto_rawDesc), len(file_events_proto_rawDesc)))
+	})
+	return file_events_proto_rawDescData
+}
+
+var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_events_proto_goTypes = []any{
+	(*EventEnvelope)(nil),         // 0: events_v1.EventEnvelope
+	(*EventVersion)(nil),          // 1: events_v1.EventVersion
+	(*TraceContext)(nil),          // 2: events_v1.TraceContext
+	(*OrderItem)(nil),             // 3: events_v1.OrderItem
+	(*OrderCreated)(nil),          // 4: events_v1.OrderCreated
+	(*Address)(nil),               // 5: events_v1.Address
+	(*OrderCancelled)(nil),        // 6: events_v1.OrderCancelled
+	(*KitchenStatusChanged)(nil),  // 7: events_v1.KitchenStatusChanged
+	(*DeliveryStatusChanged)(nil), // 8: events_v1.DeliveryStatusChanged
+	(*PaymentSucceeded)(nil),      // 9: events_v1.PaymentSucceeded
+	(*PaymentFailed)(nil),         // 10: events_v1.PaymentFailed
+	(*SagaCommand)(nil),           // 11: events_v1.SagaCommand
+	(*SagaReply)(nil),             // 12: events_v1.SagaReply
+	(*OrderRefunded)(nil),         // 13: events_v1.OrderRefunded
+	(*RefundedItem)(nil),          // 14: events_v1.RefundedItem
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+}
+var file_events_proto_depIdxs = []int32{
+	1,  // 0: events_v1.EventEnvelope.version:type_name -> events_v1.EventVersion
+	15, // 1: events_v1.EventEnvelope.occurred_at:type_name -> google.protobuf.Timestamp
+	2,  // 2: events_v1.EventEnvelope.trace:type_name -> events_v1.TraceContext
+	4,  // 3: events_v1.EventEnvelope.order_created:type_name -> events_v1.OrderCreated
+	6,  // 4: events_v1.EventEnvelope.order_cancelled:type_name -> events_v1.OrderCancelled
+	7,  // 5: events_v1.EventEnvelope.kitchen_status_changed:type_name -> events_v1.KitchenStatusChanged
+	9,  // 6: events_v1.EventEnvelope.payment_succeeded:type_name -> events_v1.PaymentSucceeded
+	10, // 7: events_v1.EventEnvelope.payment_failed:type_name -> events_v1.PaymentFailed
+	11, // 8: events_v1.EventEnvelope.saga_command:type_name -> events_v1.SagaCommand
+	12, // 9: events_v1.EventEnvelope.saga_reply:type_name -> events_v1.SagaReply
+	13, // 10: events_v1.EventEnvelope.order_refunded:type_name -> events_v1.OrderRefunded
+	8,  // 11: events_v1.EventEnvelope.delivery_status_changed:type_name -> events_v1.DeliveryStatusChanged
+	3,  // 12: events_v1.OrderCreated.items:type_name -> events_v1.OrderItem
+	5,  // 13: events_v1.OrderCreated.address:type_name -> events_v1.Address
+	15, // 14: events_v1.DeliveryStatusChanged.changed_at:type_name -> google.protobuf.Timestamp
+	4,  // 15: events_v1.SagaCommand.order:type_name -> events_v1.OrderCreated
+	14, // 16: events_v1.OrderRefunded.items:type_name -> events_v1.RefundedItem
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_events_proto_init() }
+func file_events_proto_init() {
+	if File_events_proto != nil {
+		return
+	}
+	file_events_proto_msgTypes[0].OneofWrappers = []any{
+		(*EventEnvelope_OrderCreated)(nil),
+		(*EventEnvelope_OrderCancelled)(nil),
+		(*EventEnvelope_KitchenStatusChanged)(nil),
+		(*EventEnvelope_PaymentSucceeded)(nil),
+		(*EventEnvelope_PaymentFailed)(nil),
+		(*EventEnvelope_SagaCommand)(nil),
+		(*EventEnvelope_SagaReply)(nil),
+		(*EventEnvelope_OrderRefunded)(nil),
+		(*EventEnvelope_DeliveryStatusChanged)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_proto_rawDesc), len(file_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_proto_goTypes,
+		DependencyIndexes: file_events_proto_depIdxs,
+		MessageInfos:      file_events_proto_msgTypes,
+	}.Build()
+	File_events_proto = out.File
+	file_events_proto_goTypes = nil
+	file_events_proto_depIdxs = nil
+}
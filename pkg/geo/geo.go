@@ -0,0 +1,62 @@
+// Package geo holds geographic math shared by services.
+package geo
+
+import "math"
+
+const earthRadiusMeters = 6371000
+
+// Point is a WGS84 coordinate in degrees.
+type Point struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+func (p Point) Valid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lng >= -180 && p.Lng <= 180
+}
+
+// DistanceMeters returns great-circle distance between two points (haversine).
+func DistanceMeters(a, b Point) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
+
+// Polygon is a closed ring of points, the last point connects to the first one.
+// It is treated as planar, which is accurate enough for city-sized areas not
+// crossing the antimeridian.
+type Polygon []Point
+
+func (p Polygon) Valid() bool {
+	if len(p) < 3 {
+		return false
+	}
+	for _, point := range p {
+		if !point.Valid() {
+			return false
+		}
+	}
+	return true
+}
+
+// Contains reports whether point lies inside the polygon (ray casting).
+// Points exactly on an edge may be reported either way.
+func (p Polygon) Contains(point Point) bool {
+	inside := false
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		a, b := p[i], p[j]
+		if (a.Lat > point.Lat) != (b.Lat > point.Lat) {
+			lng := (b.Lng-a.Lng)*(point.Lat-a.Lat)/(b.Lat-a.Lat) + a.Lng
+			if point.Lng < lng {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
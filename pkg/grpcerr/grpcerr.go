@@ -0,0 +1,124 @@
+// Package grpcerr converts errors returned by gRPC handlers into statuses using
+// a table of domain errors provided by each service.
+package grpcerr
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Wuchinator/food-delivery/pkg/validation"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Mapping maps a domain error to a gRPC code. Field, if set, is reported as
+// a BadRequest field violation.
+type Mapping struct {
+	Err   error
+	Code  codes.Code
+	Field string
+}
+
+// contextCodes are matched after the table of a service.
+var contextCodes = []Mapping{
+	{Err: context.Canceled, Code: codes.Canceled},
+	{Err: context.DeadlineExceeded, Code: codes.DeadlineExceeded},
+}
+
+// UnaryInterceptor converts errors returned by handlers into gRPC statuses,
+// the first matching entry of table wins. Errors that are already statuses
+// pass through, unknown errors become Internal without leaking details to
+// the client.
+func UnaryInterceptor(table []Mapping, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	logger = logger.Named("grpc_errors")
+
+	return func(ctx context.Context, req any,
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		return resp, convert(table, logger, info.FullMethod, err)
+	}
+}
+
+// StreamInterceptor is UnaryInterceptor for streaming handlers.
+func StreamInterceptor(table []Mapping, logger *zap.Logger) grpc.StreamServerInterceptor {
+	logger = logger.Named("grpc_errors")
+
+	return func(srv any, stream grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		err := handler(srv, stream)
+		if err == nil {
+			return nil
+		}
+
+		return convert(table, logger, info.FullMethod, err)
+	}
+}
+
+func convert(table []Mapping, logger *zap.Logger, method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	st := toStatus(table, err)
+	if st.Code() == codes.Internal {
+		logger.Error("Unhandled error", zap.String("method", method), zap.Error(err))
+	}
+
+	return st.Err()
+}
+
+func toStatus(table []Mapping, err error) *status.Status {
+	mapping, ok := find(table, err)
+	if !ok {
+		mapping, ok = find(contextCodes, err)
+	}
+	if !ok {
+		return status.New(codes.Internal, "internal error")
+	}
+
+	st := status.New(mapping.Code, err.Error())
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	var validationErr *validation.Error
+	if errors.As(err, &validationErr) {
+		for _, v := range validationErr.Violations {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+	} else if mapping.Field != "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       mapping.Field,
+			Description: err.Error(),
+		})
+	}
+
+	if len(violations) == 0 {
+		return st
+	}
+
+	withDetails, detailsErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailsErr != nil {
+		return st
+	}
+	return withDetails
+}
+
+func find(table []Mapping, err error) (Mapping, bool) {
+	for _, mapping := range table {
+		if errors.Is(err, mapping.Err) {
+			return mapping, true
+		}
+	}
+	return Mapping{}, false
+}
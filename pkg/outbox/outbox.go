@@ -0,0 +1,37 @@
+// Package outbox publishes events stored together with a state change in the
+// outbox table. The relay delivers them to Kafka at least once.
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Message is an event stored in the same transaction as the state change
+// and published to Kafka later by the relay.
+type Message struct {
+	ID        int64
+	EventType string
+	Key       string
+	Payload   []byte
+	// ContentType is the encoding of Payload, see pkg/events.
+	ContentType string
+	CreatedAt   time.Time
+}
+
+type Stats struct {
+	Pending       int64
+	OldestPending time.Time
+}
+
+type Store interface {
+	// Dispatch locks up to limit pending messages, passes them to publish in
+	// order and marks published ones as sent. It stops on the first publish error.
+	Dispatch(ctx context.Context, limit int,
+		publish func(ctx context.Context, msg Message) error) (int, error)
+	Stats(ctx context.Context) (Stats, error)
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
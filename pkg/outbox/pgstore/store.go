@@ -0,0 +1,139 @@
+// Package pgstore keeps outbox messages in the Postgres outbox table.
+package pgstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/pkg/outbox"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const insertQuery = `
+	INSERT INTO outbox (event_type, key, payload, content_type)
+	VALUES ($1, $2, $3, $4)
+`
+
+// Insert stores the message in the transaction of the state change it reports.
+func Insert(ctx context.Context, tx pgx.Tx, msg *outbox.Message) error {
+	if _, err := tx.Exec(ctx, insertQuery, msg.EventType, msg.Key, msg.Payload, msg.ContentType); err != nil {
+		return fmt.Errorf("failed to insert outbox message: %w", err)
+	}
+
+	return nil
+}
+
+type Store struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+}
+
+func New(pool *pgxpool.Pool, logger *zap.Logger) *Store {
+	return &Store{
+		pool:   pool,
+		logger: logger.Named("outbox_repository"),
+	}
+}
+
+// Enqueue stores a message that is not tied to any other state change.
+func (s *Store) Enqueue(ctx context.Context, msg *outbox.Message) error {
+	if _, err := s.pool.Exec(ctx, insertQuery, msg.EventType, msg.Key, msg.Payload, msg.ContentType); err != nil {
+		s.logger.Error("failed to enqueue outbox message", zap.String("event_type", msg.EventType), zap.Error(err))
+		return fmt.Errorf("failed to insert outbox message: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) Dispatch(ctx context.Context, limit int,
+	publish func(ctx context.Context, msg outbox.Message) error) (int, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		SELECT id, event_type, key, payload, content_type, created_at
+		FROM outbox
+		WHERE sent_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := tx.Query(ctx, query, limit)
+	if err != nil {
+		s.logger.Error("failed to select outbox messages", zap.Error(err))
+		return 0, fmt.Errorf("select outbox messages: %w", err)
+	}
+
+	messages, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (outbox.Message, error) {
+		var msg outbox.Message
+		err := row.Scan(&msg.ID, &msg.EventType, &msg.Key, &msg.Payload, &msg.ContentType, &msg.CreatedAt)
+		return msg, err
+	})
+	if err != nil {
+		s.logger.Error("failed to scan outbox messages", zap.Error(err))
+		return 0, fmt.Errorf("scan outbox messages: %w", err)
+	}
+
+	if len(messages) == 0 {
+		return 0, nil
+	}
+
+	sent := make([]int64, 0, len(messages))
+	var publishErr error
+	for _, msg := range messages {
+		if publishErr = publish(ctx, msg); publishErr != nil {
+			_, err := tx.Exec(ctx,
+				`UPDATE outbox SET attempts = attempts + 1, last_error = $2 WHERE id = $1`,
+				msg.ID, publishErr.Error())
+			if err != nil {
+				s.logger.Error("failed to record outbox error", zap.Int64("id", msg.ID), zap.Error(err))
+			}
+			break
+		}
+		sent = append(sent, msg.ID)
+	}
+
+	if len(sent) > 0 {
+		_, err := tx.Exec(ctx,
+			`UPDATE outbox SET sent_at = now(), attempts = attempts + 1 WHERE id = ANY($1)`,
+			sent)
+		if err != nil {
+			s.logger.Error("failed to mark outbox messages as sent", zap.Error(err))
+			return 0, fmt.Errorf("mark outbox messages as sent: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Error("failed to commit transaction", zap.Error(err))
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if publishErr != nil {
+		return len(sent), fmt.Errorf("publish outbox message: %w", publishErr)
+	}
+
+	return len(sent), nil
+}
+
+func (s *Store) Stats(ctx context.Context) (outbox.Stats, error) {
+	query := `
+		SELECT count(*), COALESCE(min(created_at), now())
+		FROM outbox
+		WHERE sent_at IS NULL
+	`
+
+	var stats outbox.Stats
+	if err := s.pool.QueryRow(ctx, query).Scan(&stats.Pending, &stats.OldestPending); err != nil {
+		s.logger.Error("failed to get outbox stats", zap.Error(err))
+		return stats, fmt.Errorf("get outbox stats: %w", err)
+	}
+
+	return stats, nil
+}
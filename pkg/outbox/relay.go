@@ -0,0 +1,135 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+type metrics struct {
+	published     prometheus.Counter
+	publishErrors prometheus.Counter
+	pending       prometheus.Gauge
+	lag           prometheus.Gauge
+}
+
+var (
+	metricsMu  sync.Mutex
+	registered = make(map[string]*metrics)
+)
+
+func metricsFor(namespace string) *metrics {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+
+	if m, ok := registered[namespace]; ok {
+		return m
+	}
+
+	m := &metrics{
+		published: promauto.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "outbox_published_total",
+			Help:      "Number of outbox messages published to Kafka.",
+		}),
+		publishErrors: promauto.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "outbox_publish_errors_total",
+			Help:      "Number of failed outbox dispatch attempts.",
+		}),
+		pending: promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "outbox_pending_messages",
+			Help:      "Number of outbox messages waiting to be published.",
+		}),
+		lag: promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "outbox_lag_seconds",
+			Help:      "Age of the oldest outbox message waiting to be published.",
+		}),
+	}
+	registered[namespace] = m
+	return m
+}
+
+type RelayConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	// MetricsNamespace prefixes the relay metrics, e.g. "order".
+	MetricsNamespace string
+}
+
+// Relay delivers outbox messages to Kafka at least once.
+type Relay struct {
+	store     Store
+	publisher Publisher
+	cfg       RelayConfig
+	metrics   *metrics
+	logger    *zap.Logger
+}
+
+func NewRelay(store Store, publisher Publisher, cfg RelayConfig, logger *zap.Logger) *Relay {
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		cfg:       cfg,
+		metrics:   metricsFor(cfg.MetricsNamespace),
+		logger:    logger.Named("outbox_relay"),
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	r.logger.Info("Outbox relay has been started")
+
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Drain while there are full batches, then wait for the next tick.
+		for r.dispatch(ctx) == r.cfg.BatchSize {
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		r.updateLag(ctx)
+
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Relay) dispatch(ctx context.Context) int {
+	sent, err := r.store.Dispatch(ctx, r.cfg.BatchSize, r.publisher.Publish)
+	r.metrics.published.Add(float64(sent))
+	if err != nil {
+		if ctx.Err() == nil {
+			r.metrics.publishErrors.Inc()
+			r.logger.Error("Failed to dispatch outbox messages", zap.Int("sent", sent), zap.Error(err))
+		}
+		return 0
+	}
+
+	return sent
+}
+
+func (r *Relay) updateLag(ctx context.Context) {
+	stats, err := r.store.Stats(ctx)
+	if err != nil {
+		return
+	}
+
+	r.metrics.pending.Set(float64(stats.Pending))
+	if stats.Pending == 0 {
+		r.metrics.lag.Set(0)
+		return
+	}
+	r.metrics.lag.Set(time.Since(stats.OldestPending).Seconds())
+}
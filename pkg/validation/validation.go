@@ -0,0 +1,64 @@
+// Package validation collects invalid request fields into a single error that
+// gRPC handlers report as BadRequest field violations.
+package validation
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// FieldViolation describes why a single request field is invalid.
+// Err is the domain error behind the violation, if any.
+type FieldViolation struct {
+	Field       string
+	Description string
+	Err         error
+}
+
+// Error collects all invalid fields of a request. It matches
+// ErrInvalidArgument and errors of its violations.
+type Error struct {
+	Violations []FieldViolation
+}
+
+func New(field, description string) *Error {
+	return &Error{
+		Violations: []FieldViolation{{Field: field, Description: description}},
+	}
+}
+
+func (e *Error) Add(field, description string) {
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Description: description})
+}
+
+func (e *Error) AddErr(field string, err error) {
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Description: err.Error(), Err: err})
+}
+
+// Err returns nil if no violations were collected.
+func (e *Error) Err() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *Error) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		parts = append(parts, v.Field+": "+v.Description)
+	}
+	return "invalid argument: " + strings.Join(parts, "; ")
+}
+
+func (e *Error) Unwrap() []error {
+	errs := []error{ErrInvalidArgument}
+	for _, v := range e.Violations {
+		if v.Err != nil {
+			errs = append(errs, v.Err)
+		}
+	}
+	return errs
+}
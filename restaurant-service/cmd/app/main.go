@@ -1,5 +1,130 @@
 package main
 
+import (
+	"log"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/pkg/consumer"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/Wuchinator/food-delivery/pkg/outbox"
+	"github.com/Wuchinator/food-delivery/pkg/outbox/pgstore"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/adapter/db/postgres"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/adapter/kafka"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/app"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/app/database"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/app/logger"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/config"
+	restaurantGrpc "github.com/Wuchinator/food-delivery/restaurant-service/internal/handler/grpc"
+	kafkaHandler "github.com/Wuchinator/food-delivery/restaurant-service/internal/handler/kafka"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/usecase"
+	pb "github.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+)
+
 func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal("Failed to load config", err)
+	}
+
+	log, err := logger.NewLogger(cfg.LoggerLevel, cfg.Environment)
+	if err != nil {
+		log.Fatal("Failed to init logger")
+	}
+	defer log.Sync()
+	log = logger.WithService(log, "restaurant-service")
+	log.Info("Starting restaurant service", zap.String("environment", cfg.Environment), zap.String("grpc port", cfg.GRPCPort))
+
+	db, err := database.NewConn(database.Config{
+		DSN:          cfg.Postgres.PostgresDSN(),
+		MaxOpenConns: cfg.Postgres.MaxOpenConns,
+		MaxIdleConns: cfg.Postgres.MaxIdleConns,
+		Timeout:      cfg.Postgres.MaxConnLifeTime,
+	}, log)
+
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+
+	defer db.Close()
+
+	restaurantRepo := postgres.NewRestaurantRepository(db.Pool, log)
+	kitchenRepo := postgres.NewKitchenRepository(db.Pool, log)
+
+	producer := kafka.NewProducer(kafka.ProducerConfig{
+		Brokers:      cfg.Kafka.Brokers,
+		Topic:        cfg.Kafka.StatusTopic,
+		RepliesTopic: cfg.Kafka.RepliesTopic,
+		WriteTimeout: cfg.Kafka.TimeOut,
+		EventFormat:  cfg.Kafka.EventFormat,
+	}, log)
+
+	defer producer.Close()
+
+	// Kitchen statuses go through the outbox, READY must reach order-service
+	// to capture the payment and dispatch a courier.
+	outboxRelay := outbox.NewRelay(
+		pgstore.New(db.Pool, log),
+		producer,
+		outbox.RelayConfig{
+			PollInterval:     cfg.Outbox.PollInterval,
+			BatchSize:        cfg.Outbox.BatchSize,
+			MetricsNamespace: "restaurant",
+		}, log)
+
+	encoder := events.NewEncoder(cfg.Kafka.EventFormat)
+	kitchenUC := usecase.NewKitchenStatusUseCase(kitchenRepo, encoder, log)
+
+	sagaConsumer := consumer.NewConsumer(consumer.Config{
+		Brokers: cfg.Kafka.Brokers,
+		Topic:   cfg.Kafka.Topic,
+		GroupID: cfg.Kafka.GroupID,
+		TimeOut: cfg.Kafka.TimeOut,
+
+		DLQTopic: cfg.Kafka.DLQTopic,
+		Retry: consumer.RetryPolicy{
+			MaxAttempts:    cfg.Kafka.MaxAttempts,
+			InitialBackoff: cfg.Kafka.InitialBackoff,
+			MaxBackoff:     cfg.Kafka.MaxBackoff,
+		},
+		Pool: consumer.PoolConfig{
+			Workers:        cfg.Kafka.Workers,
+			QueueSize:      cfg.Kafka.QueueSize,
+			CommitInterval: cfg.Kafka.CommitInterval,
+			DrainTimeout:   cfg.Kafka.DrainTimeout,
+		},
+
+		MetricsNamespace: "restaurant_consumer",
+	}, kafkaHandler.NewSagaCommandHandler(
+		usecase.NewReserveItemsUseCase(restaurantRepo, log),
+		usecase.NewKitchenTicketUseCase(kitchenRepo, encoder, log),
+		producer, log), log)
+
+	defer sagaConsumer.Close()
+
+	grpcServer := grpc.NewServer(
+		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
+		grpc.ChainUnaryInterceptor(
+			grpc_prometheus.UnaryServerInterceptor,
+			restaurantGrpc.ErrorUnaryInterceptor(log),
+		),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: 5 * time.Minute,
+			Timeout:           20 * time.Second,
+		}),
+	)
+
+	grpc_prometheus.Register(grpcServer)
+
+	restaurantHandler := restaurantGrpc.NewServer(restaurantRepo, log)
+	pb.RegisterRestaurantServiceServer(grpcServer, restaurantHandler)
+	pb.RegisterKitchenServiceServer(grpcServer, restaurantGrpc.NewKitchenServer(kitchenRepo, kitchenUC, log))
+	reflection.Register(grpcServer)
 
+	App := app.NewApp(cfg, log, grpcServer, outboxRelay, sagaConsumer)
+	App.Run()
 }
@@ -0,0 +1,248 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Wuchinator/food-delivery/pkg/outbox/pgstore"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type KitchenRepository struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+}
+
+func NewKitchenRepository(pool *pgxpool.Pool, logger *zap.Logger) *KitchenRepository {
+	return &KitchenRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+func (r *KitchenRepository) Create(ctx context.Context, order *domain.KitchenOrder,
+	event domain.KitchenEventFactory) (int64, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Kafka may redeliver OrderCreated, one ticket per order_id.
+	query := `INSERT INTO kitchen_orders (order_id, restaurant_id, status, created_at, updated_at)
+	 VALUES ($1, $2, $3, $4, $4)
+	 ON CONFLICT (order_id) DO NOTHING
+	 RETURNING id`
+
+	var kitchenOrderID int64
+	err = tx.QueryRow(ctx, query,
+		order.OrderID, order.RestaurantID, order.Status, order.CreatedAt).Scan(&kitchenOrderID)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = tx.QueryRow(ctx,
+			`SELECT id FROM kitchen_orders WHERE order_id = $1`, order.OrderID).Scan(&kitchenOrderID)
+		if err != nil {
+			r.logger.Error("Failed to select existing kitchen order", zap.Error(err))
+			return 0, err
+		}
+		return kitchenOrderID, domain.ErrKitchenOrderExists
+	}
+
+	if err != nil {
+		r.logger.Error("Failed to insert kitchen order", zap.Error(err))
+		return 0, err
+	}
+
+	rows := make([][]any, 0, len(order.Items))
+	for _, item := range order.Items {
+		rows = append(rows, []any{kitchenOrderID, item.ProductID, item.Quantity})
+	}
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"kitchen_order_items"},
+		[]string{"kitchen_order_id", "product_id", "quantity"},
+		pgx.CopyFromRows(rows))
+	if err != nil {
+		r.logger.Error("Failed to insert kitchen order items", zap.Error(err))
+		return 0, err
+	}
+
+	created := *order
+	created.ID = kitchenOrderID
+	msg, err := event(&created)
+	if err != nil {
+		return 0, err
+	}
+	if err := pgstore.Insert(ctx, tx, msg); err != nil {
+		r.logger.Error("Failed to insert outbox message", zap.Int64("id", kitchenOrderID), zap.Error(err))
+		return 0, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		r.logger.Error("Failed to commit transaction", zap.Error(err))
+		return 0, err
+	}
+
+	return kitchenOrderID, nil
+}
+
+func (r *KitchenRepository) GetByID(ctx context.Context, id int64) (*domain.KitchenOrder, error) {
+	return r.get(ctx, "id", id)
+}
+
+func (r *KitchenRepository) GetByOrderID(ctx context.Context, orderID int64) (*domain.KitchenOrder, error) {
+	return r.get(ctx, "order_id", orderID)
+}
+
+// get loads a ticket with its items by a unique column.
+func (r *KitchenRepository) get(ctx context.Context, column string, id int64) (*domain.KitchenOrder, error) {
+	query := `SELECT id, order_id, restaurant_id, status, created_at, updated_at
+	 FROM kitchen_orders
+	 WHERE ` + column + ` = $1`
+
+	rows, err := r.pool.Query(ctx, query, id)
+	if err != nil {
+		r.logger.Error("Failed to select kitchen order", zap.Int64(column, id), zap.Error(err))
+		return nil, err
+	}
+
+	order, err := pgx.CollectExactlyOneRow(rows, scanKitchenOrder)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrKitchenOrderNotFound
+		}
+		r.logger.Error("Failed to scan kitchen order", zap.Int64(column, id), zap.Error(err))
+		return nil, err
+	}
+
+	orders := []domain.KitchenOrder{order}
+	if err := r.loadItems(ctx, orders); err != nil {
+		return nil, err
+	}
+
+	return &orders[0], nil
+}
+
+func (r *KitchenRepository) List(ctx context.Context,
+	restaurantID int64, statuses []domain.KitchenStatus) ([]domain.KitchenOrder, error) {
+
+	query := `SELECT id, order_id, restaurant_id, status, created_at, updated_at
+	 FROM kitchen_orders
+	 WHERE restaurant_id = $1 AND status = ANY($2)
+	 ORDER BY id`
+
+	values := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		values = append(values, string(status))
+	}
+
+	rows, err := r.pool.Query(ctx, query, restaurantID, values)
+	if err != nil {
+		r.logger.Error("Failed to select kitchen orders", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	orders, err := pgx.CollectRows(rows, scanKitchenOrder)
+	if err != nil {
+		r.logger.Error("Failed to scan kitchen orders", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	if err := r.loadItems(ctx, orders); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (r *KitchenRepository) loadItems(ctx context.Context, orders []domain.KitchenOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	index := make(map[int64]int, len(orders))
+	ids := make([]int64, 0, len(orders))
+	for i, order := range orders {
+		index[order.ID] = i
+		ids = append(ids, order.ID)
+	}
+
+	query := `SELECT kitchen_order_id, product_id, quantity
+	 FROM kitchen_order_items
+	 WHERE kitchen_order_id = ANY($1)
+	 ORDER BY id`
+
+	rows, err := r.pool.Query(ctx, query, ids)
+	if err != nil {
+		r.logger.Error("Failed to select kitchen order items", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			kitchenOrderID int64
+			item           domain.KitchenItem
+		)
+		if err := rows.Scan(&kitchenOrderID, &item.ProductID, &item.Quantity); err != nil {
+			r.logger.Error("Failed to scan kitchen order item", zap.Error(err))
+			return err
+		}
+		i := index[kitchenOrderID]
+		orders[i].Items = append(orders[i].Items, item)
+	}
+
+	return rows.Err()
+}
+
+func (r *KitchenRepository) UpdateStatus(ctx context.Context, id int64,
+	from, to domain.KitchenStatus, events ...*domain.OutboxMessage) error {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE kitchen_orders
+	 SET status = $3, updated_at = CURRENT_TIMESTAMP
+	 WHERE id = $1 AND status = $2`
+
+	tag, err := tx.Exec(ctx, query, id, from, to)
+	if err != nil {
+		r.logger.Error("Failed to update kitchen order status", zap.Int64("id", id), zap.Error(err))
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrKitchenStatusConflict
+	}
+
+	for _, msg := range events {
+		if err := pgstore.Insert(ctx, tx, msg); err != nil {
+			r.logger.Error("Failed to insert outbox message", zap.Int64("id", id), zap.Error(err))
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("Failed to commit transaction", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func scanKitchenOrder(row pgx.CollectableRow) (domain.KitchenOrder, error) {
+	var order domain.KitchenOrder
+	err := row.Scan(&order.ID, &order.OrderID, &order.RestaurantID,
+		&order.Status, &order.CreatedAt, &order.UpdatedAt)
+	return order, err
+}
@@ -2,8 +2,13 @@ package postgres
 
 import (
 	"context"
-	"restaurant/internal/domain"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
@@ -52,14 +57,311 @@ func (r *RestaurantRepository) CreateMenuItem(ctx context.Context, Menu *domain.
 	return MenuID, nil
 }
 
-func (r *RestaurantRepository) GetMenu(ctx context.Context, restaurantID int64) ([]domain.MenuItem, error) {
-	return nil, nil
+func (r *RestaurantRepository) GetRestaurant(ctx context.Context, restaurantID int64) (*domain.Restaurant, error) {
+	query := `SELECT id, name, address, latitude, longitude, delivery_radius_m,
+	 prep_time_minutes, timezone, paused_until, pause_reason
+	 FROM restaurants
+	 WHERE id = $1`
+
+	var restaurant domain.Restaurant
+	var (
+		prepMinutes int
+		pausedUntil pgtype.Timestamptz
+	)
+	err := r.pool.QueryRow(ctx, query, restaurantID).Scan(
+		&restaurant.ID,
+		&restaurant.Name,
+		&restaurant.Address,
+		&restaurant.Location.Lat,
+		&restaurant.Location.Lng,
+		&restaurant.DeliveryRadius,
+		&prepMinutes,
+		&restaurant.Timezone,
+		&pausedUntil,
+		&restaurant.PauseReason,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrRestaurantNotFound
+		}
+		r.logger.Error("Failed to select restaurant", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	restaurant.PrepTime = time.Duration(prepMinutes) * time.Minute
+	if pausedUntil.Valid {
+		restaurant.PausedUntil = pausedUntil.Time
+	}
+
+	return &restaurant, nil
+}
+
+func (r *RestaurantRepository) GetSchedule(ctx context.Context, restaurantID int64) (*domain.Schedule, error) {
+	var timezone string
+	err := r.pool.QueryRow(ctx, `SELECT timezone FROM restaurants WHERE id = $1`, restaurantID).Scan(&timezone)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrRestaurantNotFound
+		}
+		r.logger.Error("Failed to select restaurant timezone", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		r.logger.Error("Invalid restaurant timezone",
+			zap.Int64("restaurant_id", restaurantID), zap.String("timezone", timezone), zap.Error(err))
+		return nil, fmt.Errorf("restaurant %d timezone: %w", restaurantID, err)
+	}
+
+	schedule := &domain.Schedule{Location: location}
+
+	rows, err := r.pool.Query(ctx, `SELECT weekday, opens_at, closes_at
+	 FROM opening_hours
+	 WHERE restaurant_id = $1`, restaurantID)
+	if err != nil {
+		r.logger.Error("Failed to select opening hours", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	schedule.Weekly, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.OpeningHours, error) {
+		var hours domain.OpeningHours
+		var opens, closes pgtype.Time
+		if err := row.Scan(&hours.Weekday, &opens, &closes); err != nil {
+			return hours, err
+		}
+		hours.Opens, hours.Closes = toClockTime(opens), toClockTime(closes)
+		return hours, nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to scan opening hours", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	// Yesterday is needed for windows running past midnight.
+	rows, err = r.pool.Query(ctx, `SELECT date, opens_at, closes_at
+	 FROM holiday_hours
+	 WHERE restaurant_id = $1 AND date >= CURRENT_DATE - 1`, restaurantID)
+	if err != nil {
+		r.logger.Error("Failed to select holiday hours", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	schedule.Holidays, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.HolidayHours, error) {
+		var holiday domain.HolidayHours
+		var opens, closes pgtype.Time
+		if err := row.Scan(&holiday.Date, &opens, &closes); err != nil {
+			return holiday, err
+		}
+		holiday.Closed = !opens.Valid
+		holiday.Opens, holiday.Closes = toClockTime(opens), toClockTime(closes)
+		return holiday, nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to scan holiday hours", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func toClockTime(t pgtype.Time) domain.ClockTime {
+	return domain.ClockTime(time.Duration(t.Microseconds) * time.Microsecond / time.Minute)
+}
+
+func fromClockTime(c domain.ClockTime) pgtype.Time {
+	return pgtype.Time{Microseconds: int64(time.Duration(c) * time.Minute / time.Microsecond), Valid: true}
 }
 
-func (r *RestaurantRepository) UpdateMenu(ctx context.Context, item *domain.MenuItem) error {
+func (r *RestaurantRepository) SetSchedule(ctx context.Context, restaurantID int64, schedule *domain.Schedule) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `UPDATE restaurants
+	 SET timezone = $2, updated_at = CURRENT_TIMESTAMP
+	 WHERE id = $1`, restaurantID, schedule.Location.String())
+	if err != nil {
+		r.logger.Error("Failed to update restaurant timezone", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrRestaurantNotFound
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM opening_hours WHERE restaurant_id = $1`, restaurantID); err != nil {
+		r.logger.Error("Failed to delete opening hours", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return err
+	}
+
+	for _, hours := range schedule.Weekly {
+		_, err := tx.Exec(ctx, `INSERT INTO opening_hours (restaurant_id, weekday, opens_at, closes_at)
+		 VALUES ($1, $2, $3, $4)`, restaurantID, hours.Weekday, fromClockTime(hours.Opens), fromClockTime(hours.Closes))
+		if err != nil {
+			r.logger.Error("Failed to insert opening hours", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM holiday_hours WHERE restaurant_id = $1`, restaurantID); err != nil {
+		r.logger.Error("Failed to delete holiday hours", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return err
+	}
+
+	for _, holiday := range schedule.Holidays {
+		var opens, closes pgtype.Time
+		if !holiday.Closed {
+			opens, closes = fromClockTime(holiday.Opens), fromClockTime(holiday.Closes)
+		}
+		_, err := tx.Exec(ctx, `INSERT INTO holiday_hours (restaurant_id, date, opens_at, closes_at)
+		 VALUES ($1, $2, $3, $4)`, restaurantID, holiday.Date, opens, closes)
+		if err != nil {
+			r.logger.Error("Failed to insert holiday hours", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error("Failed to commit schedule", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return err
+	}
+
 	return nil
 }
 
+func (r *RestaurantRepository) SetPause(ctx context.Context, restaurantID int64, until time.Time, reason string) error {
+	var pausedUntil pgtype.Timestamptz
+	if !until.IsZero() {
+		pausedUntil = pgtype.Timestamptz{Time: until, Valid: true}
+	}
+
+	tag, err := r.pool.Exec(ctx, `UPDATE restaurants
+	 SET paused_until = $2, pause_reason = $3, updated_at = CURRENT_TIMESTAMP
+	 WHERE id = $1`, restaurantID, pausedUntil, reason)
+	if err != nil {
+		r.logger.Error("Failed to update restaurant pause", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrRestaurantNotFound
+	}
+
+	return nil
+}
+
+func (r *RestaurantRepository) ListDeliveryZones(ctx context.Context, restaurantID int64) ([]domain.DeliveryZone, error) {
+	query := `SELECT id, restaurant_id, name, polygon
+	 FROM delivery_zones
+	 WHERE restaurant_id = $1
+	 ORDER BY id`
+
+	rows, err := r.pool.Query(ctx, query, restaurantID)
+	if err != nil {
+		r.logger.Error("Failed to select delivery zones", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	zones, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.DeliveryZone, error) {
+		var zone domain.DeliveryZone
+		err := row.Scan(&zone.ID, &zone.RestaurantID, &zone.Name, &zone.Polygon)
+		return zone, err
+	})
+	if err != nil {
+		r.logger.Error("Failed to scan delivery zones", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+func (r *RestaurantRepository) GetMenu(ctx context.Context, restaurantID int64) ([]domain.MenuItem, error) {
+	query := `SELECT id, restaurant_id, product_id, name, price, description, is_available, updated_at
+	 FROM menu
+	 WHERE restaurant_id = $1 AND deleted_at IS NULL
+	 ORDER BY product_id`
+
+	rows, err := r.pool.Query(ctx, query, restaurantID)
+	if err != nil {
+		r.logger.Error("Failed to select menu", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	items, err := pgx.CollectRows(rows, scanMenuItem)
+	if err != nil {
+		r.logger.Error("Failed to scan menu", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		// Empty menu and unknown restaurant look the same, tell them apart.
+		var exists bool
+		err := r.pool.QueryRow(ctx,
+			`SELECT EXISTS(SELECT 1 FROM restaurants WHERE id = $1)`, restaurantID).Scan(&exists)
+		if err != nil {
+			r.logger.Error("Failed to check restaurant", zap.Int64("restaurant_id", restaurantID), zap.Error(err))
+			return nil, err
+		}
+		if !exists {
+			return nil, domain.ErrRestaurantNotFound
+		}
+	}
+
+	return items, nil
+}
+
+func (r *RestaurantRepository) UpdateMenu(ctx context.Context, update domain.MenuItemUpdate) (*domain.MenuItem, error) {
+	query := `UPDATE menu
+	 SET price = COALESCE($3, price),
+	     description = COALESCE($4, description),
+	     is_available = COALESCE($5, is_available),
+	     updated_at = CURRENT_TIMESTAMP
+	 WHERE restaurant_id = $1 AND product_id = $2 AND deleted_at IS NULL
+	 RETURNING id, restaurant_id, product_id, name, price, description, is_available, updated_at`
+
+	rows, err := r.pool.Query(ctx, query,
+		update.RestaurantID, update.ProductID,
+		update.Price, update.Description, update.IsAvailable)
+	if err != nil {
+		r.logger.Error("Failed to update menu item", zap.Error(err))
+		return nil, err
+	}
+
+	item, err := pgx.CollectExactlyOneRow(rows, scanMenuItem)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrMenuItemNotFound
+		}
+		r.logger.Error("Failed to update menu item", zap.Error(err))
+		return nil, err
+	}
+
+	return &item, nil
+}
+
 func (r *RestaurantRepository) DeleteMenu(ctx context.Context, restaurantID int64, itemID int64) error {
+	query := `UPDATE menu
+	 SET deleted_at = CURRENT_TIMESTAMP, is_available = FALSE, updated_at = CURRENT_TIMESTAMP
+	 WHERE restaurant_id = $1 AND id = $2 AND deleted_at IS NULL`
+
+	tag, err := r.pool.Exec(ctx, query, restaurantID, itemID)
+	if err != nil {
+		r.logger.Error("Failed to delete menu item", zap.Error(err))
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrMenuItemNotFound
+	}
+
 	return nil
 }
+
+func scanMenuItem(row pgx.CollectableRow) (domain.MenuItem, error) {
+	var item domain.MenuItem
+	err := row.Scan(&item.ID, &item.RestaurantID, &item.ProductID, &item.Name,
+		&item.Price, &item.Description, &item.IsAvailable, &item.UpdatedAt)
+	return item, err
+}
@@ -0,0 +1,122 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"github.com/Wuchinator/food-delivery/pkg/outbox"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/domain"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+type ProducerConfig struct {
+	Brokers      []string
+	Topic        string
+	RepliesTopic string
+	WriteTimeout time.Duration
+	EventFormat  events.Format
+}
+
+type Producer struct {
+	writer  *kafka.Writer
+	replies *kafka.Writer
+	encoder *events.Encoder
+	logger  *zap.Logger
+}
+
+func NewProducer(cfg ProducerConfig, logger *zap.Logger) *Producer {
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Topic:        cfg.Topic,
+		Balancer:     &kafka.Hash{},
+		WriteTimeout: cfg.WriteTimeout,
+		RequiredAcks: kafka.RequireAll,
+	}
+
+	replies := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Topic:        cfg.RepliesTopic,
+		Balancer:     &kafka.Hash{},
+		WriteTimeout: cfg.WriteTimeout,
+		RequiredAcks: kafka.RequireAll,
+	}
+
+	return &Producer{
+		writer:  writer,
+		replies: replies,
+		encoder: events.NewEncoder(cfg.EventFormat),
+		logger:  logger.Named("kafka_producer"),
+	}
+}
+
+// Publish writes an outbox message to the kitchen status topic. Messages are
+// keyed by order id, so statuses of one order stay ordered within a partition.
+func (p *Producer) Publish(ctx context.Context, msg outbox.Message) error {
+	if msg.EventType != domain.EventKitchenStatusChanged {
+		return fmt.Errorf("no topic for event type %q", msg.EventType)
+	}
+
+	err := p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(msg.Key),
+		Value: msg.Payload,
+		Headers: []kafka.Header{
+			{Key: "event_type", Value: []byte(msg.EventType)},
+			{Key: events.HeaderContentType, Value: []byte(msg.ContentType)},
+		},
+	})
+	if err != nil {
+		p.logger.Error("Failed to write message", zap.Error(err))
+		return err
+	}
+
+	p.logger.Info("Outbox message sent to Kafka",
+		zap.Int64("outbox_id", msg.ID),
+		zap.String("event_type", msg.EventType))
+
+	return nil
+}
+
+// SendSagaReply answers a saga command, keyed by order id like the commands.
+func (p *Producer) SendSagaReply(ctx context.Context, reply *eventspb.SagaReply) error {
+	env, err := events.New(ctx, reply)
+	if err != nil {
+		return err
+	}
+
+	value, err := p.encoder.Encode(env)
+	if err != nil {
+		p.logger.Error("Failed to marshal event", zap.Error(err))
+		return err
+	}
+
+	err = p.replies.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatInt(reply.OrderId, 10)),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: "event_type", Value: []byte(env.Type)},
+			{Key: events.HeaderContentType, Value: []byte(p.encoder.ContentType())},
+		},
+	})
+	if err != nil {
+		p.logger.Error("Failed to write message", zap.Error(err))
+		return err
+	}
+
+	p.logger.Info("SagaReply sent to Kafka",
+		zap.Int64("order_id", reply.OrderId),
+		zap.String("step", reply.Step),
+		zap.Bool("success", reply.Success))
+
+	return nil
+}
+
+func (p *Producer) Close() error {
+	p.logger.Info("Producer close")
+	return errors.Join(p.writer.Close(), p.replies.Close())
+}
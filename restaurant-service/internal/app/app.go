@@ -1 +1,116 @@
 package app
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/config"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Worker is a background process that runs until ctx is cancelled.
+type Worker interface {
+	Run(ctx context.Context)
+}
+
+type App struct {
+	cfg        *config.Config
+	logger     *zap.Logger
+	grpcServer *grpc.Server
+	httpServer *http.Server
+	workers    []Worker
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+func NewApp(cfg *config.Config,
+	logger *zap.Logger,
+	grpcServer *grpc.Server,
+	workers ...Worker) *App {
+
+	httpServer := &http.Server{
+		Addr:              ":" + cfg.MetricsPort,
+		Handler:           promhttp.Handler(),
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	return &App{
+		cfg:        cfg,
+		logger:     logger,
+		grpcServer: grpcServer,
+		httpServer: httpServer,
+		workers:    workers,
+	}
+}
+
+func (a *App) Run() {
+	go func() {
+		a.logger.Info("Starting metrics server", zap.String("addr", a.httpServer.Addr))
+		if err := a.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			a.logger.Fatal("Failed to start metrics server", zap.Error(err))
+		}
+	}()
+
+	listener, err := net.Listen("tcp", ":"+a.cfg.GRPCPort)
+	if err != nil {
+		a.logger.Fatal("Failed to create grpc listener", zap.Error(err))
+	}
+
+	go func() {
+		a.logger.Info("Starting grpc server", zap.String("addr", a.cfg.GRPCPort))
+		if err := a.grpcServer.Serve(listener); err != nil {
+			a.logger.Fatal("Failed to start grpc server", zap.Error(err))
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancel = cancel
+	for _, w := range a.workers {
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			w.Run(ctx)
+		}()
+	}
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	a.logger.Info("Shutting down app...")
+
+	a.Stop()
+}
+
+func (a *App) Stop() {
+
+	const timeOut = 5 * time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeOut)
+	defer cancel()
+
+	a.logger.Info("Stopping grpc server...")
+	a.grpcServer.GracefulStop()
+
+	a.logger.Info("Stopping background workers...")
+	if a.cancel != nil {
+		a.cancel()
+	}
+	a.wg.Wait()
+
+	a.logger.Info("Stopping HTTP server...")
+	if err := a.httpServer.Shutdown(ctx); err != nil {
+		a.logger.Warn("HTTP server shutdown error", zap.Error(err))
+	}
+
+	a.logger.Info("Application stopped")
+}
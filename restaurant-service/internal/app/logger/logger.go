@@ -0,0 +1,47 @@
+package logger
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func NewLogger(level, env string) (*zap.Logger, error) {
+
+	var config zap.Config
+
+	switch env {
+	case "production":
+		config = zap.NewProductionConfig()
+		config.Encoding = "json"
+	default:
+		config = zap.NewDevelopmentConfig()
+		config.Encoding = "console"
+		config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	}
+
+	var zapLevel zapcore.Level
+
+	if err := zapLevel.UnmarshalText([]byte(level)); err != nil {
+		zapLevel = zapcore.InfoLevel
+	}
+
+	config.Level = zap.NewAtomicLevelAt(zapLevel)
+
+	config.EncoderConfig.CallerKey = "caller"
+	config.EncoderConfig.TimeKey = "timestamp"
+	config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	logger, err := config.Build(
+		zap.AddCaller(),
+		zap.AddStacktrace(zapcore.ErrorLevel),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+	return logger, nil
+}
+
+func WithService(logger *zap.Logger, serviceName string) *zap.Logger {
+	return logger.With(zap.String("service", serviceName))
+}
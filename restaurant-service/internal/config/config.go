@@ -3,11 +3,13 @@ package config
 // TODO: Разнести на файлы оставив лишь интерфейс для подключения
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Wuchinator/food-delivery/pkg/events"
 	"github.com/joho/godotenv"
 )
 
@@ -18,6 +20,7 @@ type Config struct {
 	MetricsPort string
 	Kafka       KafkaConfig
 	Postgres    PostgresConfig
+	Outbox      OutboxConfig
 }
 
 type PostgresConfig struct {
@@ -32,11 +35,30 @@ type PostgresConfig struct {
 	SSLmode         string
 }
 
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
 type KafkaConfig struct {
-	Brokers []string
-	Topic   string
-	GroupID string
-	TimeOut time.Duration
+	Brokers     []string
+	Topic       string
+	GroupID     string
+	TimeOut     time.Duration
+	StatusTopic string
+	EventFormat events.Format
+	// RepliesTopic receives replies to order saga commands read from Topic.
+	RepliesTopic string
+
+	DLQTopic       string
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	Workers        int
+	QueueSize      int
+	CommitInterval time.Duration
+	DrainTimeout   time.Duration
 }
 
 func Load() (*Config, error) {
@@ -63,16 +85,51 @@ func Load() (*Config, error) {
 		SSLmode:         getEnv("POSTGRES_SSL_MODE", "disable"),
 	}
 
+	eventFormat, err := events.ParseFormat(getEnv("KAFKA_EVENT_FORMAT", string(events.FormatJSON)))
+	if err != nil {
+		return nil, err
+	}
+
 	brokers := getEnv("KAFKA_BROKERS", "localhost:9092")
 	cfg.Kafka = KafkaConfig{
-		Brokers: strings.Split(brokers, ","),
-		Topic:   getEnv("KAFKA_TOPIC", "restaurant"),
-		GroupID: getEnv("GROUP_ID", "restaurant-group"),
-		TimeOut: getEnvAsDuration("TIMEOUT", time.Second*30),
+		Brokers:     strings.Split(brokers, ","),
+		Topic:       getEnv("KAFKA_TOPIC", "restaurant-commands"),
+		GroupID:     getEnv("GROUP_ID", "restaurant-group"),
+		TimeOut:     getEnvAsDuration("TIMEOUT", time.Second*30),
+		StatusTopic: getEnv("KAFKA_TOPIC_KITCHEN_STATUS", "kitchen-status"),
+		EventFormat: eventFormat,
+
+		RepliesTopic: getEnv("KAFKA_TOPIC_SAGA_REPLIES", "saga-replies"),
+
+		DLQTopic:       getEnv("KAFKA_DLQ_TOPIC", ""),
+		MaxAttempts:    getEnvAsInt("KAFKA_MAX_ATTEMPTS", 5),
+		InitialBackoff: getEnvAsDuration("KAFKA_INITIAL_BACKOFF", 200*time.Millisecond),
+		MaxBackoff:     getEnvAsDuration("KAFKA_MAX_BACKOFF", 10*time.Second),
+
+		Workers:        getEnvAsInt("KAFKA_CONSUMER_WORKERS", 1),
+		QueueSize:      getEnvAsInt("KAFKA_CONSUMER_QUEUE_SIZE", 64),
+		CommitInterval: getEnvAsDuration("KAFKA_COMMIT_INTERVAL", time.Second),
+		DrainTimeout:   getEnvAsDuration("KAFKA_DRAIN_TIMEOUT", 10*time.Second),
+	}
+
+	cfg.Outbox = OutboxConfig{
+		PollInterval: getEnvAsDuration("OUTBOX_POLL_INTERVAL", time.Second),
+		BatchSize:    getEnvAsInt("OUTBOX_BATCH_SIZE", 100),
 	}
 	return cfg, nil
 }
 
+func (cfg *PostgresConfig) PostgresDSN() string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
+		cfg.User,
+		cfg.Password,
+		cfg.Host,
+		cfg.Port,
+		cfg.Database,
+		cfg.SSLmode,
+	)
+}
+
 func getEnvAsDuration(Val string, defaultVal time.Duration) time.Duration {
 	strVal := os.Getenv(Val)
 	if val, err := time.ParseDuration(strVal); err == nil {
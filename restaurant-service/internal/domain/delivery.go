@@ -0,0 +1,57 @@
+package domain
+
+import "github.com/Wuchinator/food-delivery/pkg/geo"
+
+// DeliveryZone is an area a restaurant delivers to in addition to its delivery radius.
+type DeliveryZone struct {
+	ID           int64
+	RestaurantID int64
+	Name         string
+	Polygon      geo.Polygon
+}
+
+// Deliverability is the result of a delivery check for a point.
+type Deliverability struct {
+	Deliverable bool
+	// Distance from the restaurant in meters.
+	Distance float64
+	// Zone is the name of the matching zone, "radius" for the delivery radius
+	// and "any" if the restaurant has no delivery area configured.
+	Zone string
+}
+
+const (
+	RadiusZone = "radius"
+	AnyZone    = "any"
+)
+
+// CheckDelivery tells whether the restaurant delivers to point, either
+// within its delivery radius or inside one of the zones. A restaurant without
+// radius and zones has no delivery area configured and delivers anywhere.
+func (r *Restaurant) CheckDelivery(point geo.Point, zones []DeliveryZone) Deliverability {
+	result := Deliverability{
+		Distance: geo.DistanceMeters(r.Location, point),
+	}
+
+	if r.DeliveryRadius == 0 && len(zones) == 0 {
+		result.Deliverable = true
+		result.Zone = AnyZone
+		return result
+	}
+
+	if r.DeliveryRadius > 0 && result.Distance <= float64(r.DeliveryRadius) {
+		result.Deliverable = true
+		result.Zone = RadiusZone
+		return result
+	}
+
+	for _, zone := range zones {
+		if zone.Polygon.Contains(point) {
+			result.Deliverable = true
+			result.Zone = zone.Name
+			return result
+		}
+	}
+
+	return result
+}
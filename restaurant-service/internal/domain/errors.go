@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/Wuchinator/food-delivery/pkg/validation"
+)
+
+var (
+	ErrRestaurantNotFound    = errors.New("restaurant not found")
+	ErrMenuItemNotFound      = errors.New("menu item not found")
+	ErrKitchenOrderExists    = errors.New("kitchen order already exists")
+	ErrKitchenOrderNotFound  = errors.New("kitchen order not found")
+	ErrKitchenStatusConflict = errors.New("kitchen order status was changed concurrently")
+	ErrInvalidKitchenStatus  = errors.New("invalid kitchen status transition")
+	ErrInvalidArgument       = validation.ErrInvalidArgument
+	ErrProductUnavailable    = errors.New("product is unknown or unavailable")
+)
+
+// FieldViolation describes why a single request field is invalid.
+type FieldViolation = validation.FieldViolation
+
+// ValidationError collects all invalid fields of a request. It matches
+// ErrInvalidArgument and errors of its violations.
+type ValidationError = validation.Error
+
+func NewValidationError(field, description string) *ValidationError {
+	return validation.New(field, description)
+}
@@ -11,15 +11,32 @@ const (
 	KitchenStatusAccepted  KitchenStatus = "ACCEPTED"
 	KitchenStatusPreparing KitchenStatus = "PREPARING"
 	KitchenStatusReady     KitchenStatus = "READY"
+	KitchenStatusCancelled KitchenStatus = "CANCELLED"
 )
 
+// Kitchen tickets only move forward: ACCEPTED -> PREPARING -> READY.
+// The order saga cancels tickets that are not ready yet.
+var kitchenTransitions = map[KitchenStatus]KitchenStatus{
+	KitchenStatusAccepted:  KitchenStatusPreparing,
+	KitchenStatusPreparing: KitchenStatusReady,
+}
+
+func (s KitchenStatus) CanTransitionTo(to KitchenStatus) bool {
+	if to == KitchenStatusCancelled {
+		return s == KitchenStatusAccepted || s == KitchenStatusPreparing
+	}
+	next, ok := kitchenTransitions[s]
+	return ok && next == to
+}
+
 type KitchenOrder struct {
 	ID           int64
 	OrderID      int64
 	RestaurantID int64
 	Status       KitchenStatus
-	Items        *KitchenItem
+	Items        []KitchenItem
 	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
 type KitchenItem struct {
@@ -28,6 +45,16 @@ type KitchenItem struct {
 }
 
 type KitchenRepository interface {
-	Create(ctx context.Context, order *KitchenOrder) (int64, error)
-	UpdateStatus(ctx context.Context, id int64, status KitchenStatus) error
+	// Create stores the ticket and the event built by event in one transaction.
+	// It returns ErrKitchenOrderExists with the id of existing ticket if a
+	// ticket for the same order_id was already created, without storing the event.
+	Create(ctx context.Context, order *KitchenOrder, event KitchenEventFactory) (int64, error)
+	GetByID(ctx context.Context, id int64) (*KitchenOrder, error)
+	// GetByOrderID returns ErrKitchenOrderNotFound if the order has no ticket.
+	GetByOrderID(ctx context.Context, orderID int64) (*KitchenOrder, error)
+	// List returns tickets of the restaurant in given statuses, oldest first.
+	List(ctx context.Context, restaurantID int64, statuses []KitchenStatus) ([]KitchenOrder, error)
+	// UpdateStatus stores events in the same transaction as the new status. It returns
+	// ErrKitchenStatusConflict if the ticket is no longer in status `from`.
+	UpdateStatus(ctx context.Context, id int64, from, to KitchenStatus, events ...*OutboxMessage) error
 }
@@ -0,0 +1,12 @@
+package domain
+
+import "github.com/Wuchinator/food-delivery/pkg/outbox"
+
+const EventKitchenStatusChanged = "KitchenStatusChanged"
+
+// OutboxMessage is an event stored in the same transaction as the ticket change
+// and published to Kafka later by the outbox relay.
+type OutboxMessage = outbox.Message
+
+// KitchenEventFactory builds the outbox message for a ticket after it got its id.
+type KitchenEventFactory func(order *KitchenOrder) (*OutboxMessage, error)
@@ -1,11 +1,26 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+)
 
 type Restaurant struct {
-	ID      int64
-	Name    string
-	Address string
+	ID       int64
+	Name     string
+	Address  string
+	Location geo.Point
+	// DeliveryRadius is in meters, zero skips the radius check, see CheckDelivery.
+	DeliveryRadius int
+	// PrepTime is how long the kitchen usually needs to cook an order.
+	PrepTime time.Duration
+	// Timezone is an IANA name, opening hours are in this timezone.
+	Timezone string
+	// PausedUntil stops accepting orders regardless of opening hours.
+	PausedUntil time.Time
+	PauseReason string
 }
 
 type MenuItem struct {
@@ -16,11 +31,33 @@ type MenuItem struct {
 	Name         string
 	Description  string
 	IsAvailable  bool
+	UpdatedAt    time.Time
+}
+
+// MenuItemUpdate is a partial update of a menu item, nil fields are left as is.
+type MenuItemUpdate struct {
+	RestaurantID int64
+	ProductID    int64
+	Price        *int64
+	Description  *string
+	IsAvailable  *bool
 }
 
 type RestaurantRepository interface {
+	// GetRestaurant returns ErrRestaurantNotFound for unknown restaurant.
+	GetRestaurant(ctx context.Context, restaurantID int64) (*Restaurant, error)
+	ListDeliveryZones(ctx context.Context, restaurantID int64) ([]DeliveryZone, error)
+	// GetSchedule returns weekly hours and holidays starting from yesterday.
+	GetSchedule(ctx context.Context, restaurantID int64) (*Schedule, error)
+	// SetSchedule replaces the timezone, weekly hours and holidays of the restaurant.
+	SetSchedule(ctx context.Context, restaurantID int64, schedule *Schedule) error
+	// SetPause pauses the restaurant until the given time, zero time resumes it.
+	SetPause(ctx context.Context, restaurantID int64, until time.Time, reason string) error
+	// GetMenu returns ErrRestaurantNotFound for unknown restaurant.
 	GetMenu(ctx context.Context, restaurantID int64) ([]MenuItem, error)
-	UpdateMenu(ctx context.Context, item *MenuItem) error
+	// UpdateMenu returns ErrMenuItemNotFound if there is no such item on the menu.
+	UpdateMenu(ctx context.Context, update MenuItemUpdate) (*MenuItem, error)
 	CreateMenuItem(ctx context.Context, Menu *MenuItem) (int64, error)
+	// DeleteMenu soft-deletes the item, it disappears from the menu.
 	DeleteMenu(ctx context.Context, restaurantID int64, itemID int64) error
 }
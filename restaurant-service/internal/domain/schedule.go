@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// lookahead limits the search of the next opening window.
+const lookahead = 14
+
+// ClockTime is a time of day in minutes since local midnight.
+type ClockTime int
+
+func (c ClockTime) String() string {
+	return fmt.Sprintf("%02d:%02d", int(c)/60, int(c)%60)
+}
+
+// ParseClockTime parses "HH:MM" in 24-hour format.
+func ParseClockTime(s string) (ClockTime, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+	return ClockTime(t.Hour()*60 + t.Minute()), nil
+}
+
+// OpeningHours is a regular weekly window. Closes not after Opens means the
+// window ends on the next day, e.g. 18:00-02:00.
+type OpeningHours struct {
+	Weekday time.Weekday
+	Opens   ClockTime
+	Closes  ClockTime
+}
+
+// HolidayHours overrides weekly hours for a single local date. Closed
+// holidays have no hours at all.
+type HolidayHours struct {
+	Date   time.Time
+	Closed bool
+	Opens  ClockTime
+	Closes ClockTime
+}
+
+// Schedule is a restaurant timetable in its own timezone. A schedule without
+// weekly hours is open around the clock, holidays still apply to it.
+type Schedule struct {
+	Location *time.Location
+	Weekly   []OpeningHours
+	Holidays []HolidayHours
+}
+
+type window struct {
+	start time.Time
+	end   time.Time
+}
+
+// windows returns opening windows starting on the local date of day.
+func (s *Schedule) windows(day time.Time) []window {
+	year, month, date := day.Date()
+
+	toWindow := func(opens, closes ClockTime) window {
+		start := time.Date(year, month, date, 0, int(opens), 0, 0, s.Location)
+		end := time.Date(year, month, date, 0, int(closes), 0, 0, s.Location)
+		if closes <= opens {
+			end = end.AddDate(0, 0, 1)
+		}
+		return window{start: start, end: end}
+	}
+
+	for _, holiday := range s.Holidays {
+		hy, hm, hd := holiday.Date.Date()
+		if hy == year && hm == month && hd == date {
+			if holiday.Closed {
+				return nil
+			}
+			return []window{toWindow(holiday.Opens, holiday.Closes)}
+		}
+	}
+
+	if len(s.Weekly) == 0 {
+		return []window{toWindow(0, 0)}
+	}
+
+	var result []window
+	for _, hours := range s.Weekly {
+		if hours.Weekday == day.Weekday() {
+			result = append(result, toWindow(hours.Opens, hours.Closes))
+		}
+	}
+	return result
+}
+
+// OpenAt returns when the restaurant open at t closes, or false if it is
+// closed. Back-to-back windows count as one, zero time means the restaurant
+// does not close within two weeks.
+func (s *Schedule) OpenAt(t time.Time) (time.Time, bool) {
+	end, open := s.windowEnd(t)
+	if !open {
+		return time.Time{}, false
+	}
+
+	for i := 0; i < lookahead; i++ {
+		next, open := s.windowEnd(end)
+		if !open {
+			return end, true
+		}
+		end = next
+	}
+	return time.Time{}, true
+}
+
+// windowEnd returns the end of the window open at t.
+func (s *Schedule) windowEnd(t time.Time) (time.Time, bool) {
+	local := t.In(s.Location)
+	// Windows of the previous day may run past midnight.
+	for _, day := range []time.Time{local.AddDate(0, 0, -1), local} {
+		for _, w := range s.windows(day) {
+			if !t.Before(w.start) && t.Before(w.end) {
+				return w.end, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// NextOpening returns the start of the first window after t, or false if there
+// is none within two weeks.
+func (s *Schedule) NextOpening(t time.Time) (time.Time, bool) {
+	local := t.In(s.Location)
+	var next time.Time
+	for i := 0; i <= lookahead; i++ {
+		for _, w := range s.windows(local.AddDate(0, 0, i)) {
+			if w.start.After(t) && (next.IsZero() || w.start.Before(next)) {
+				next = w.start
+			}
+		}
+		if !next.IsZero() {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// RestaurantStatus tells whether a restaurant accepts orders at some moment.
+type RestaurantStatus struct {
+	Open   bool
+	Paused bool
+	// Reason is set for closed restaurants.
+	Reason string
+	// ClosesAt is set for open restaurants unless they are open around the clock.
+	ClosesAt time.Time
+	// NextOpenAt is set for closed restaurants if they open within two weeks.
+	NextOpenAt time.Time
+}
+
+// Status evaluates the schedule and the pause of the restaurant at t.
+func (r *Restaurant) Status(schedule *Schedule, t time.Time) RestaurantStatus {
+	if t.Before(r.PausedUntil) {
+		status := RestaurantStatus{
+			Paused: true,
+			Reason: "paused",
+		}
+		if r.PauseReason != "" {
+			status.Reason = "paused: " + r.PauseReason
+		}
+
+		if _, open := schedule.OpenAt(r.PausedUntil); open {
+			status.NextOpenAt = r.PausedUntil
+		} else if next, ok := schedule.NextOpening(r.PausedUntil); ok {
+			status.NextOpenAt = next
+		}
+		return status
+	}
+
+	if closesAt, open := schedule.OpenAt(t); open {
+		return RestaurantStatus{Open: true, ClosesAt: closesAt}
+	}
+
+	status := RestaurantStatus{Reason: "closed"}
+	if next, ok := schedule.NextOpening(t); ok {
+		status.NextOpenAt = next
+	}
+	return status
+}
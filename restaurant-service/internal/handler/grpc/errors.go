@@ -0,0 +1,26 @@
+package grpc
+
+import (
+	"github.com/Wuchinator/food-delivery/pkg/grpcerr"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/domain"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// errorCodes maps domain errors to gRPC codes, first match wins. Field, if set,
+// is reported as a BadRequest field violation.
+var errorCodes = []grpcerr.Mapping{
+	{Err: domain.ErrRestaurantNotFound, Code: codes.NotFound},
+	{Err: domain.ErrMenuItemNotFound, Code: codes.NotFound},
+	{Err: domain.ErrKitchenOrderNotFound, Code: codes.NotFound},
+	{Err: domain.ErrInvalidArgument, Code: codes.InvalidArgument},
+	{Err: domain.ErrInvalidKitchenStatus, Code: codes.FailedPrecondition},
+	{Err: domain.ErrKitchenStatusConflict, Code: codes.FailedPrecondition},
+	{Err: domain.ErrKitchenOrderExists, Code: codes.AlreadyExists},
+}
+
+// ErrorUnaryInterceptor converts errors returned by handlers into gRPC statuses.
+func ErrorUnaryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return grpcerr.UnaryInterceptor(errorCodes, logger)
+}
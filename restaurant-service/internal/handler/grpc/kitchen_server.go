@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/usecase"
+	pb "github.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	kitchenStatusToPb = map[domain.KitchenStatus]pb.KitchenStatus{
+		domain.KitchenStatusAccepted:  pb.KitchenStatus_KITCHEN_STATUS_ACCEPTED,
+		domain.KitchenStatusPreparing: pb.KitchenStatus_KITCHEN_STATUS_PREPARING,
+		domain.KitchenStatusReady:     pb.KitchenStatus_KITCHEN_STATUS_READY,
+	}
+	kitchenStatusFromPb = map[pb.KitchenStatus]domain.KitchenStatus{
+		pb.KitchenStatus_KITCHEN_STATUS_ACCEPTED:  domain.KitchenStatusAccepted,
+		pb.KitchenStatus_KITCHEN_STATUS_PREPARING: domain.KitchenStatusPreparing,
+		pb.KitchenStatus_KITCHEN_STATUS_READY:     domain.KitchenStatusReady,
+	}
+)
+
+type KitchenServer struct {
+	pb.UnimplementedKitchenServiceServer
+	repo    domain.KitchenRepository
+	usecase *usecase.KitchenStatusUseCase
+	logger  *zap.Logger
+}
+
+func NewKitchenServer(repo domain.KitchenRepository,
+	uc *usecase.KitchenStatusUseCase, logger *zap.Logger) *KitchenServer {
+	return &KitchenServer{
+		repo:    repo,
+		usecase: uc,
+		logger:  logger,
+	}
+}
+
+func (s *KitchenServer) ListKitchenOrders(ctx context.Context,
+	req *pb.ListKitchenOrdersRequest) (*pb.ListKitchenOrdersResponse, error) {
+
+	if req.RestaurantId <= 0 {
+		return nil, domain.NewValidationError("restaurant_id", "must be positive")
+	}
+
+	statuses := []domain.KitchenStatus{domain.KitchenStatusAccepted, domain.KitchenStatusPreparing}
+	if req.Status != pb.KitchenStatus_KITCHEN_STATUS_UNSPECIFIED {
+		st, ok := kitchenStatusFromPb[req.Status]
+		if !ok {
+			return nil, domain.NewValidationError("status", "unknown status")
+		}
+		statuses = []domain.KitchenStatus{st}
+	}
+
+	orders, err := s.repo.List(ctx, req.RestaurantId, statuses)
+	if err != nil {
+		s.logger.Error("Failed to list kitchen orders", zap.Int64("restaurant_id", req.RestaurantId), zap.Error(err))
+		return nil, err
+	}
+
+	resp := &pb.ListKitchenOrdersResponse{
+		Orders: make([]*pb.KitchenOrder, 0, len(orders)),
+	}
+	for i := range orders {
+		resp.Orders = append(resp.Orders, toPbKitchenOrder(&orders[i]))
+	}
+
+	return resp, nil
+}
+
+func (s *KitchenServer) UpdateKitchenOrderStatus(ctx context.Context,
+	req *pb.UpdateKitchenOrderStatusRequest) (*pb.UpdateKitchenOrderStatusResponse, error) {
+
+	violations := &domain.ValidationError{}
+	if req.KitchenOrderId <= 0 {
+		violations.Add("kitchen_order_id", "must be positive")
+	}
+	to, ok := kitchenStatusFromPb[req.Status]
+	if !ok {
+		violations.Add("status", "unknown status")
+	}
+	if err := violations.Err(); err != nil {
+		return nil, err
+	}
+
+	order, err := s.usecase.Advance(ctx, req.KitchenOrderId, to)
+	if err != nil {
+		s.logger.Error("Failed to update kitchen order status",
+			zap.Int64("kitchen_order_id", req.KitchenOrderId), zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.UpdateKitchenOrderStatusResponse{
+		Order: toPbKitchenOrder(order),
+	}, nil
+}
+
+func toPbKitchenOrder(order *domain.KitchenOrder) *pb.KitchenOrder {
+	items := make([]*pb.KitchenItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, &pb.KitchenItem{
+			ProductId: item.ProductID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	return &pb.KitchenOrder{
+		Id:           order.ID,
+		OrderId:      order.OrderID,
+		RestaurantId: order.RestaurantID,
+		Status:       kitchenStatusToPb[order.Status],
+		Items:        items,
+		CreatedAt:    timestamppb.New(order.CreatedAt),
+	}
+}
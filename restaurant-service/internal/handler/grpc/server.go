@@ -1,7 +1,345 @@
 package grpc
 
-import pb "github.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1"
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/pkg/geo"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/domain"
+	pb "github.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
 
 type Server struct {
 	pb.UnimplementedRestaurantServiceServer
+	repo   domain.RestaurantRepository
+	logger *zap.Logger
+}
+
+func NewServer(repo domain.RestaurantRepository, logger *zap.Logger) *Server {
+	return &Server{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *Server) GetRestaurant(ctx context.Context,
+	req *pb.GetRestaurantRequest) (*pb.GetRestaurantResponse, error) {
+
+	if req.RestaurantId <= 0 {
+		return nil, domain.NewValidationError("restaurant_id", "must be positive")
+	}
+
+	restaurant, err := s.repo.GetRestaurant(ctx, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetRestaurantResponse{
+		Restaurant: &pb.Restaurant{
+			Id:              restaurant.ID,
+			Name:            restaurant.Name,
+			Address:         restaurant.Address,
+			Lat:             restaurant.Location.Lat,
+			Lng:             restaurant.Location.Lng,
+			DeliveryRadiusM: int32(restaurant.DeliveryRadius),
+			PrepTimeMinutes: int32(restaurant.PrepTime / time.Minute),
+		},
+	}, nil
+}
+
+func (s *Server) CheckDeliverable(ctx context.Context,
+	req *pb.CheckDeliverableRequest) (*pb.CheckDeliverableResponse, error) {
+
+	point := geo.Point{Lat: req.Lat, Lng: req.Lng}
+
+	violations := &domain.ValidationError{}
+	if req.RestaurantId <= 0 {
+		violations.Add("restaurant_id", "must be positive")
+	}
+	if !point.Valid() {
+		violations.Add("lat", "coordinates are out of range")
+	}
+	if err := violations.Err(); err != nil {
+		return nil, err
+	}
+
+	restaurant, err := s.repo.GetRestaurant(ctx, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	zones, err := s.repo.ListDeliveryZones(ctx, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := restaurant.CheckDelivery(point, zones)
+
+	return &pb.CheckDeliverableResponse{
+		Deliverable: result.Deliverable,
+		DistanceM:   result.Distance,
+		Zone:        result.Zone,
+	}, nil
+}
+
+func (s *Server) GetRestaurantStatus(ctx context.Context,
+	req *pb.GetRestaurantStatusRequest) (*pb.GetRestaurantStatusResponse, error) {
+
+	if req.RestaurantId <= 0 {
+		return nil, domain.NewValidationError("restaurant_id", "must be positive")
+	}
+
+	at := time.Now()
+	if req.At != nil {
+		at = req.At.AsTime()
+	}
+
+	restaurant, err := s.repo.GetRestaurant(ctx, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.repo.GetSchedule(ctx, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	st := restaurant.Status(schedule, at)
+
+	resp := &pb.GetRestaurantStatusResponse{
+		Open:     st.Open,
+		Paused:   st.Paused,
+		Reason:   st.Reason,
+		Timezone: restaurant.Timezone,
+	}
+	if !st.ClosesAt.IsZero() {
+		resp.ClosesAt = timestamppb.New(st.ClosesAt)
+	}
+	if !st.NextOpenAt.IsZero() {
+		resp.NextOpenAt = timestamppb.New(st.NextOpenAt)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) PauseRestaurant(ctx context.Context,
+	req *pb.PauseRestaurantRequest) (*pb.PauseRestaurantResponse, error) {
+
+	if req.RestaurantId <= 0 {
+		return nil, domain.NewValidationError("restaurant_id", "must be positive")
+	}
+
+	var until time.Time
+	if req.PausedUntil != nil && req.PausedUntil.AsTime().After(time.Now()) {
+		until = req.PausedUntil.AsTime()
+	}
+
+	if err := s.repo.SetPause(ctx, req.RestaurantId, until, req.Reason); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Restaurant pause updated",
+		zap.Int64("restaurant_id", req.RestaurantId),
+		zap.Time("paused_until", until))
+
+	return &pb.PauseRestaurantResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *Server) GetOpeningHours(ctx context.Context,
+	req *pb.GetOpeningHoursRequest) (*pb.GetOpeningHoursResponse, error) {
+
+	if req.RestaurantId <= 0 {
+		return nil, domain.NewValidationError("restaurant_id", "must be positive")
+	}
+
+	schedule, err := s.repo.GetSchedule(ctx, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetOpeningHoursResponse{
+		Timezone: schedule.Location.String(),
+		Weekly:   make([]*pb.OpeningHours, 0, len(schedule.Weekly)),
+		Holidays: make([]*pb.HolidayHours, 0, len(schedule.Holidays)),
+	}
+	for _, hours := range schedule.Weekly {
+		resp.Weekly = append(resp.Weekly, &pb.OpeningHours{
+			Weekday: int32(hours.Weekday),
+			Opens:   hours.Opens.String(),
+			Closes:  hours.Closes.String(),
+		})
+	}
+	for _, holiday := range schedule.Holidays {
+		pbHoliday := &pb.HolidayHours{
+			Date:   holiday.Date.Format(time.DateOnly),
+			Closed: holiday.Closed,
+		}
+		if !holiday.Closed {
+			pbHoliday.Opens = holiday.Opens.String()
+			pbHoliday.Closes = holiday.Closes.String()
+		}
+		resp.Holidays = append(resp.Holidays, pbHoliday)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) SetOpeningHours(ctx context.Context,
+	req *pb.SetOpeningHoursRequest) (*pb.SetOpeningHoursResponse, error) {
+
+	violations := &domain.ValidationError{}
+	if req.RestaurantId <= 0 {
+		violations.Add("restaurant_id", "must be positive")
+	}
+
+	schedule := &domain.Schedule{}
+	if req.Timezone != "" {
+		location, err := time.LoadLocation(req.Timezone)
+		if err != nil {
+			violations.Add("timezone", fmt.Sprintf("unknown timezone %q", req.Timezone))
+		}
+		schedule.Location = location
+	}
+
+	for i, hours := range req.Weekly {
+		field := fmt.Sprintf("weekly[%d]", i)
+		if hours.Weekday < 0 || hours.Weekday > 6 {
+			violations.Add(field+".weekday", "must be between 0 (Sunday) and 6")
+		}
+		opens := parseClockTime(violations, field+".opens", hours.Opens)
+		closes := parseClockTime(violations, field+".closes", hours.Closes)
+		schedule.Weekly = append(schedule.Weekly, domain.OpeningHours{
+			Weekday: time.Weekday(hours.Weekday),
+			Opens:   opens,
+			Closes:  closes,
+		})
+	}
+
+	dates := make(map[string]bool, len(req.Holidays))
+	for i, holiday := range req.Holidays {
+		field := fmt.Sprintf("holidays[%d]", i)
+		date, err := time.Parse(time.DateOnly, holiday.Date)
+		if err != nil {
+			violations.Add(field+".date", fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", holiday.Date))
+		}
+		if dates[holiday.Date] {
+			violations.Add(field+".date", fmt.Sprintf("date %s is listed twice", holiday.Date))
+		}
+		dates[holiday.Date] = true
+
+		hours := domain.HolidayHours{Date: date, Closed: holiday.Closed}
+		if !holiday.Closed {
+			hours.Opens = parseClockTime(violations, field+".opens", holiday.Opens)
+			hours.Closes = parseClockTime(violations, field+".closes", holiday.Closes)
+		}
+		schedule.Holidays = append(schedule.Holidays, hours)
+	}
+
+	if err := violations.Err(); err != nil {
+		return nil, err
+	}
+
+	if schedule.Location == nil {
+		current, err := s.repo.GetSchedule(ctx, req.RestaurantId)
+		if err != nil {
+			return nil, err
+		}
+		schedule.Location = current.Location
+	}
+
+	if err := s.repo.SetSchedule(ctx, req.RestaurantId, schedule); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Restaurant opening hours updated",
+		zap.Int64("restaurant_id", req.RestaurantId),
+		zap.String("timezone", schedule.Location.String()),
+		zap.Int("weekly", len(schedule.Weekly)),
+		zap.Int("holidays", len(schedule.Holidays)))
+
+	return &pb.SetOpeningHoursResponse{
+		Success: true,
+	}, nil
+}
+
+func parseClockTime(violations *domain.ValidationError, field, value string) domain.ClockTime {
+	clock, err := domain.ParseClockTime(value)
+	if err != nil {
+		violations.Add(field, err.Error())
+	}
+	return clock
+}
+
+func (s *Server) GetMenu(ctx context.Context, req *pb.GetMenuRequest) (*pb.GetMenuResponse, error) {
+	if req.RestaurantId <= 0 {
+		return nil, domain.NewValidationError("restaurant_id", "must be positive")
+	}
+
+	menu, err := s.repo.GetMenu(ctx, req.RestaurantId)
+	if err != nil {
+		s.logger.Error("Failed to get menu", zap.Int64("restaurant_id", req.RestaurantId), zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]*pb.MenuItem, 0, len(menu))
+	for _, item := range menu {
+		items = append(items, &pb.MenuItem{
+			ProductId:   item.ProductID,
+			Name:        item.Name,
+			Description: item.Description,
+			Price:       item.Price,
+			IsAvailable: item.IsAvailable,
+		})
+	}
+
+	return &pb.GetMenuResponse{
+		Items: items,
+	}, nil
+}
+
+func (s *Server) UpdateMenuItem(ctx context.Context,
+	req *pb.UpdateMenuItemRequest) (*pb.UpdateMenuItemResponse, error) {
+
+	violations := &domain.ValidationError{}
+	if req.RestaurantId <= 0 {
+		violations.Add("restaurant_id", "must be positive")
+	}
+	if req.ProductId <= 0 {
+		violations.Add("product_id", "must be positive")
+	}
+	if req.NewPrice == nil && req.NewDescription == nil && req.IsAvailable == nil {
+		violations.Add("new_price", "nothing to update")
+	}
+	if req.NewPrice != nil && *req.NewPrice <= 0 {
+		violations.Add("new_price", "must be positive")
+	}
+	if err := violations.Err(); err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.UpdateMenu(ctx, domain.MenuItemUpdate{
+		RestaurantID: req.RestaurantId,
+		ProductID:    req.ProductId,
+		Price:        req.NewPrice,
+		Description:  req.NewDescription,
+		IsAvailable:  req.IsAvailable,
+	})
+	if err != nil {
+		s.logger.Error("Failed to update menu item", zap.Int64("product_id", req.ProductId), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("Menu item updated",
+		zap.Int64("restaurant_id", item.RestaurantID),
+		zap.Int64("product_id", item.ProductID))
+
+	return &pb.UpdateMenuItemResponse{
+		Success:   true,
+		UpdatedAt: timestamppb.New(item.UpdatedAt),
+	}, nil
 }
@@ -0,0 +1,149 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/pkg/consumer"
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/domain"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/usecase"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+type SagaReplier interface {
+	SendSagaReply(ctx context.Context, reply *eventspb.SagaReply) error
+}
+
+// SagaCommandHandler runs restaurant steps of the order saga: item reservation
+// and the kitchen ticket. Business failures are replied, other errors are
+// retried by the consumer.
+type SagaCommandHandler struct {
+	reserve *usecase.ReserveItemsUseCase
+	tickets *usecase.KitchenTicketUseCase
+	replier SagaReplier
+	logger  *zap.Logger
+}
+
+func NewSagaCommandHandler(reserve *usecase.ReserveItemsUseCase, tickets *usecase.KitchenTicketUseCase,
+	replier SagaReplier, logger *zap.Logger) *SagaCommandHandler {
+	return &SagaCommandHandler{
+		reserve: reserve,
+		tickets: tickets,
+		replier: replier,
+		logger:  logger.Named("saga_command_handler"),
+	}
+}
+
+func (h *SagaCommandHandler) Handle(ctx context.Context, message kafka.Message) error {
+	env, err := events.Decode(header(message, events.HeaderContentType), message.Value)
+	if err != nil {
+		// Unknown major versions and broken payloads will not get better on retry.
+		h.logger.Error("Failed to decode saga command", zap.Error(err))
+		return consumer.Permanent(fmt.Errorf("decode saga command: %w", err))
+	}
+
+	cmd := env.GetSagaCommand()
+	if cmd == nil {
+		h.logger.Warn("Skip unexpected event type", zap.String("type", env.Type))
+		return nil
+	}
+
+	ctx = events.ContextWithTrace(ctx, env.Trace)
+
+	var reference string
+	switch {
+	case cmd.Step == events.StepReserveItems && !cmd.Compensate:
+		err = h.reserveItems(ctx, cmd)
+	case cmd.Step == events.StepReserveItems:
+		// Nothing is held by the reservation.
+		err = nil
+	case cmd.Step == events.StepConfirmTicket && !cmd.Compensate:
+		reference, err = h.confirmTicket(ctx, cmd)
+	case cmd.Step == events.StepConfirmTicket:
+		err = h.tickets.Cancel(ctx, cmd.OrderId)
+	default:
+		h.logger.Error("Unknown saga step", zap.String("step", cmd.Step))
+		return consumer.Permanent(fmt.Errorf("unknown saga step %q", cmd.Step))
+	}
+
+	if err != nil && !isStepFailure(err) {
+		return err
+	}
+
+	if err := h.replier.SendSagaReply(ctx, events.Reply(cmd, reference, err)); err != nil {
+		return fmt.Errorf("send saga reply: %w", err)
+	}
+
+	h.logger.Info("Saga command handled",
+		zap.Int64("order_id", cmd.OrderId),
+		zap.String("step", cmd.Step),
+		zap.Bool("compensate", cmd.Compensate),
+		zap.NamedError("failure", err))
+
+	return nil
+}
+
+func (h *SagaCommandHandler) reserveItems(ctx context.Context, cmd *eventspb.SagaCommand) error {
+	order := cmd.GetOrder()
+	if order == nil {
+		return consumer.Permanent(fmt.Errorf("saga command %s for order %d has no order", cmd.Step, cmd.OrderId))
+	}
+
+	productIDs := make([]int64, 0, len(order.Items))
+	for _, item := range order.Items {
+		productIDs = append(productIDs, item.ProductId)
+	}
+
+	return h.reserve.Exec(ctx, order.RestaurantId, productIDs)
+}
+
+func (h *SagaCommandHandler) confirmTicket(ctx context.Context, cmd *eventspb.SagaCommand) (string, error) {
+	event := cmd.GetOrder()
+	if event == nil || event.RestaurantId <= 0 || len(event.Items) == 0 {
+		return "", consumer.Permanent(fmt.Errorf("invalid order in saga command for order %d", cmd.OrderId))
+	}
+
+	items := make([]domain.KitchenItem, 0, len(event.Items))
+	for _, item := range event.Items {
+		items = append(items, domain.KitchenItem{
+			ProductID: item.ProductId,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	id, err := h.tickets.Confirm(ctx, &domain.KitchenOrder{
+		OrderID:      cmd.OrderId,
+		RestaurantID: event.RestaurantId,
+		Status:       domain.KitchenStatusAccepted,
+		Items:        items,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatInt(id, 10), nil
+}
+
+// isStepFailure reports whether err is a business refusal that is replied to
+// the saga instead of being retried.
+func isStepFailure(err error) bool {
+	return errors.Is(err, domain.ErrProductUnavailable) ||
+		errors.Is(err, domain.ErrRestaurantNotFound) ||
+		errors.Is(err, domain.ErrInvalidKitchenStatus)
+}
+
+func header(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
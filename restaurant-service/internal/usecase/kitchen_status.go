@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	eventspb "github.com/Wuchinator/food-delivery/pkg/events_v1"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+// KitchenStatusUseCase advances kitchen tickets and lets order-service know about
+// it through the outbox.
+type KitchenStatusUseCase struct {
+	repo    domain.KitchenRepository
+	encoder *events.Encoder
+	logger  *zap.Logger
+}
+
+func NewKitchenStatusUseCase(repo domain.KitchenRepository,
+	encoder *events.Encoder, logger *zap.Logger) *KitchenStatusUseCase {
+	return &KitchenStatusUseCase{
+		repo:    repo,
+		encoder: encoder,
+		logger:  logger,
+	}
+}
+
+func (uc *KitchenStatusUseCase) Advance(ctx context.Context,
+	id int64, to domain.KitchenStatus) (*domain.KitchenOrder, error) {
+
+	order, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.Status.CanTransitionTo(to) {
+		return nil, fmt.Errorf("%w: %s -> %s", domain.ErrInvalidKitchenStatus, order.Status, to)
+	}
+
+	from := order.Status
+	order.Status = to
+	order.UpdatedAt = time.Now()
+
+	// The event is stored with the status: READY is the last change of a ticket,
+	// nothing would catch up on a lost one.
+	msg, err := kitchenStatusEvent(ctx, uc.encoder)(order)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.UpdateStatus(ctx, order.ID, from, to, msg); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// kitchenStatusEvent builds KitchenStatusChanged with the current ticket status,
+// keyed by order id so statuses of one order stay ordered within a partition.
+func kitchenStatusEvent(ctx context.Context, encoder *events.Encoder) domain.KitchenEventFactory {
+	return func(order *domain.KitchenOrder) (*domain.OutboxMessage, error) {
+		env, err := events.New(ctx, &eventspb.KitchenStatusChanged{
+			KitchenOrderId: order.ID,
+			OrderId:        order.OrderID,
+			RestaurantId:   order.RestaurantID,
+			Status:         string(order.Status),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		payload, err := encoder.Encode(env)
+		if err != nil {
+			return nil, fmt.Errorf("encode kitchen status: %w", err)
+		}
+
+		return &domain.OutboxMessage{
+			EventType:   domain.EventKitchenStatusChanged,
+			Key:         strconv.FormatInt(order.OrderID, 10),
+			Payload:     payload,
+			ContentType: encoder.ContentType(),
+		}, nil
+	}
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/pkg/events"
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+// KitchenTicketUseCase opens and cancels kitchen tickets on behalf of the order saga.
+// Both operations are idempotent, saga commands may be redelivered.
+type KitchenTicketUseCase struct {
+	repo    domain.KitchenRepository
+	encoder *events.Encoder
+	logger  *zap.Logger
+}
+
+func NewKitchenTicketUseCase(repo domain.KitchenRepository,
+	encoder *events.Encoder, logger *zap.Logger) *KitchenTicketUseCase {
+	return &KitchenTicketUseCase{
+		repo:    repo,
+		encoder: encoder,
+		logger:  logger,
+	}
+}
+
+// Confirm creates the ticket in ACCEPTED and returns its id. The ACCEPTED
+// KitchenStatusChanged is stored in the same transaction as the ticket.
+func (uc *KitchenTicketUseCase) Confirm(ctx context.Context, order *domain.KitchenOrder) (int64, error) {
+	id, err := uc.repo.Create(ctx, order, kitchenStatusEvent(ctx, uc.encoder))
+	if err != nil {
+		if errors.Is(err, domain.ErrKitchenOrderExists) {
+			// ACCEPTED was stored in the outbox with the ticket, a redelivered
+			// command has nothing to send again.
+			uc.logger.Info("Kitchen order already exists",
+				zap.Int64("order_id", order.OrderID),
+				zap.Int64("kitchen_order_id", id))
+			return id, nil
+		}
+		return 0, fmt.Errorf("create kitchen order: %w", err)
+	}
+
+	order.ID = id
+	uc.logger.Info("Kitchen order created",
+		zap.Int64("order_id", order.OrderID),
+		zap.Int64("kitchen_order_id", id))
+
+	return id, nil
+}
+
+// Cancel stops cooking of the order. A missing or already cancelled ticket is
+// not an error, a READY ticket can not be cancelled.
+func (uc *KitchenTicketUseCase) Cancel(ctx context.Context, orderID int64) error {
+	order, err := uc.repo.GetByOrderID(ctx, orderID)
+	if errors.Is(err, domain.ErrKitchenOrderNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if order.Status == domain.KitchenStatusCancelled {
+		return nil
+	}
+	if !order.Status.CanTransitionTo(domain.KitchenStatusCancelled) {
+		return fmt.Errorf("%w: %s -> %s", domain.ErrInvalidKitchenStatus, order.Status, domain.KitchenStatusCancelled)
+	}
+
+	if err := uc.repo.UpdateStatus(ctx, order.ID, order.Status, domain.KitchenStatusCancelled); err != nil {
+		return err
+	}
+
+	uc.logger.Info("Kitchen order cancelled",
+		zap.Int64("order_id", orderID),
+		zap.Int64("kitchen_order_id", order.ID))
+
+	return nil
+}
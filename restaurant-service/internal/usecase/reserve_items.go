@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Wuchinator/food-delivery/restaurant-service/internal/domain"
+	"go.uber.org/zap"
+)
+
+// ReserveItemsUseCase checks that ordered products can be cooked. Menu items
+// are not counted, so there is nothing to release when the saga compensates.
+type ReserveItemsUseCase struct {
+	repo   domain.RestaurantRepository
+	logger *zap.Logger
+}
+
+func NewReserveItemsUseCase(repo domain.RestaurantRepository, logger *zap.Logger) *ReserveItemsUseCase {
+	return &ReserveItemsUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Exec returns domain.ErrProductUnavailable if any product is not on the menu
+// or is switched off.
+func (uc *ReserveItemsUseCase) Exec(ctx context.Context, restaurantID int64, productIDs []int64) error {
+	menu, err := uc.repo.GetMenu(ctx, restaurantID)
+	if err != nil {
+		return err
+	}
+
+	available := make(map[int64]bool, len(menu))
+	for _, item := range menu {
+		available[item.ProductID] = item.IsAvailable
+	}
+
+	for _, productID := range productIDs {
+		if !available[productID] {
+			uc.logger.Info("Product can not be reserved",
+				zap.Int64("restaurant_id", restaurantID),
+				zap.Int64("product_id", productID))
+			return fmt.Errorf("product %d: %w", productID, domain.ErrProductUnavailable)
+		}
+	}
+
+	return nil
+}
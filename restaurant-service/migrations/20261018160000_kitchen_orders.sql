@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS kitchen_orders (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL UNIQUE,
+    restaurant_id BIGINT NOT NULL REFERENCES restaurants(id),
+    status TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS kitchen_order_items (
+    id BIGSERIAL PRIMARY KEY,
+    kitchen_order_id BIGINT NOT NULL REFERENCES kitchen_orders(id) ON DELETE CASCADE,
+    product_id BIGINT NOT NULL,
+    quantity INT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_kitchen_orders_restaurant_id_status ON kitchen_orders (restaurant_id, status);
+CREATE INDEX IF NOT EXISTS idx_kitchen_order_items_kitchen_order_id ON kitchen_order_items (kitchen_order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS kitchen_order_items;
+DROP TABLE IF EXISTS kitchen_orders;
+-- +goose StatementEnd
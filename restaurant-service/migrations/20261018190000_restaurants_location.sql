@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE restaurants ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE restaurants ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION NOT NULL DEFAULT 0;
+-- 0 skips the radius check: only delivery zones apply, without them the restaurant delivers anywhere.
+ALTER TABLE restaurants ADD COLUMN IF NOT EXISTS delivery_radius_m INT NOT NULL DEFAULT 0
+    CHECK (delivery_radius_m >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE restaurants DROP COLUMN IF EXISTS delivery_radius_m;
+ALTER TABLE restaurants DROP COLUMN IF EXISTS longitude;
+ALTER TABLE restaurants DROP COLUMN IF EXISTS latitude;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE restaurants ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'UTC';
+ALTER TABLE restaurants ADD COLUMN IF NOT EXISTS paused_until TIMESTAMPTZ;
+ALTER TABLE restaurants ADD COLUMN IF NOT EXISTS pause_reason TEXT NOT NULL DEFAULT '';
+
+-- Weekly hours in restaurant timezone, weekday 0 is Sunday. Restaurants
+-- without weekly hours are open around the clock.
+-- closes_at <= opens_at means the window ends on the next day.
+CREATE TABLE IF NOT EXISTS opening_hours (
+    id BIGSERIAL PRIMARY KEY,
+    restaurant_id BIGINT NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_opening_hours_restaurant_id ON opening_hours (restaurant_id);
+
+-- Overrides weekly hours for a date, NULL hours mean closed all day.
+CREATE TABLE IF NOT EXISTS holiday_hours (
+    restaurant_id BIGINT NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    opens_at TIME,
+    closes_at TIME,
+    PRIMARY KEY (restaurant_id, date),
+    CHECK ((opens_at IS NULL) = (closes_at IS NULL))
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS holiday_hours;
+DROP TABLE IF EXISTS opening_hours;
+ALTER TABLE restaurants DROP COLUMN IF EXISTS pause_reason;
+ALTER TABLE restaurants DROP COLUMN IF EXISTS paused_until;
+ALTER TABLE restaurants DROP COLUMN IF EXISTS timezone;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Typical time from accepting an order to handing it over to a courier.
+ALTER TABLE restaurants ADD COLUMN IF NOT EXISTS prep_time_minutes INT NOT NULL DEFAULT 20 CHECK (prep_time_minutes >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE restaurants DROP COLUMN IF EXISTS prep_time_minutes;
+-- +goose StatementEnd
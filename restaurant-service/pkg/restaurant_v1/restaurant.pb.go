@@ -22,6 +22,961 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type KitchenStatus int32
+
+const (
+	KitchenStatus_KITCHEN_STATUS_UNSPECIFIED KitchenStatus = 0
+	KitchenStatus_KITCHEN_STATUS_ACCEPTED    KitchenStatus = 1
+	KitchenStatus_KITCHEN_STATUS_PREPARING   KitchenStatus = 2
+	KitchenStatus_KITCHEN_STATUS_READY       KitchenStatus = 3
+)
+
+// Enum value maps for KitchenStatus.
+var (
+	KitchenStatus_name = map[int32]string{
+		0: "KITCHEN_STATUS_UNSPECIFIED",
+		1: "KITCHEN_STATUS_ACCEPTED",
+		2: "KITCHEN_STATUS_PREPARING",
+		3: "KITCHEN_STATUS_READY",
+	}
+	KitchenStatus_value = map[string]int32{
+		"KITCHEN_STATUS_UNSPECIFIED": 0,
+		"KITCHEN_STATUS_ACCEPTED":    1,
+		"KITCHEN_STATUS_PREPARING":   2,
+		"KITCHEN_STATUS_READY":       3,
+	}
+)
+
+func (x KitchenStatus) Enum() *KitchenStatus {
+	p := new(KitchenStatus)
+	*p = x
+	return p
+}
+
+func (x KitchenStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KitchenStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_restaurant_proto_enumTypes[0].Descriptor()
+}
+
+func (KitchenStatus) Type() protoreflect.EnumType {
+	return &file_restaurant_proto_enumTypes[0]
+}
+
+func (x KitchenStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KitchenStatus.Descriptor instead.
+func (KitchenStatus) EnumDescriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{0}
+}
+
+type GetRestaurantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId  int64                  `protobuf:"varint,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRestaurantRequest) Reset() {
+	*x = GetRestaurantRequest{}
+	mi := &file_restaurant_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRestaurantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestaurantRequest) ProtoMessage() {}
+
+func (x *GetRestaurantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestaurantRequest.ProtoReflect.Descriptor instead.
+func (*GetRestaurantRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRestaurantRequest) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+type GetRestaurantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Restaurant    *Restaurant            `protobuf:"bytes,1,opt,name=restaurant,proto3" json:"restaurant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRestaurantResponse) Reset() {
+	*x = GetRestaurantResponse{}
+	mi := &file_restaurant_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRestaurantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestaurantResponse) ProtoMessage() {}
+
+func (x *GetRestaurantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestaurantResponse.ProtoReflect.Descriptor instead.
+func (*GetRestaurantResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRestaurantResponse) GetRestaurant() *Restaurant {
+	if x != nil {
+		return x.Restaurant
+	}
+	return nil
+}
+
+type Restaurant struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Lat     float64                `protobuf:"fixed64,4,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng     float64                `protobuf:"fixed64,5,opt,name=lng,proto3" json:"lng,omitempty"`
+	// Zero means only delivery zones apply.
+	DeliveryRadiusM int32 `protobuf:"varint,6,opt,name=delivery_radius_m,json=deliveryRadiusM,proto3" json:"delivery_radius_m,omitempty"`
+	// Typical time the kitchen needs to cook an order.
+	PrepTimeMinutes int32 `protobuf:"varint,7,opt,name=prep_time_minutes,json=prepTimeMinutes,proto3" json:"prep_time_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Restaurant) Reset() {
+	*x = Restaurant{}
+	mi := &file_restaurant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Restaurant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Restaurant) ProtoMessage() {}
+
+func (x *Restaurant) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Restaurant.ProtoReflect.Descriptor instead.
+func (*Restaurant) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Restaurant) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Restaurant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Restaurant) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Restaurant) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *Restaurant) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+func (x *Restaurant) GetDeliveryRadiusM() int32 {
+	if x != nil {
+		return x.DeliveryRadiusM
+	}
+	return 0
+}
+
+func (x *Restaurant) GetPrepTimeMinutes() int32 {
+	if x != nil {
+		return x.PrepTimeMinutes
+	}
+	return 0
+}
+
+type CheckDeliverableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId  int64                  `protobuf:"varint,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Lat           float64                `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64                `protobuf:"fixed64,3,opt,name=lng,proto3" json:"lng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDeliverableRequest) Reset() {
+	*x = CheckDeliverableRequest{}
+	mi := &file_restaurant_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDeliverableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDeliverableRequest) ProtoMessage() {}
+
+func (x *CheckDeliverableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDeliverableRequest.ProtoReflect.Descriptor instead.
+func (*CheckDeliverableRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CheckDeliverableRequest) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+func (x *CheckDeliverableRequest) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *CheckDeliverableRequest) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+type CheckDeliverableResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Deliverable bool                   `protobuf:"varint,1,opt,name=deliverable,proto3" json:"deliverable,omitempty"`
+	// Distance from the restaurant in meters.
+	DistanceM float64 `protobuf:"fixed64,2,opt,name=distance_m,json=distanceM,proto3" json:"distance_m,omitempty"`
+	// Name of the matching zone, "radius" for the delivery radius, "any" if no
+	// delivery area is configured, empty if not deliverable.
+	Zone          string `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDeliverableResponse) Reset() {
+	*x = CheckDeliverableResponse{}
+	mi := &file_restaurant_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDeliverableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDeliverableResponse) ProtoMessage() {}
+
+func (x *CheckDeliverableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDeliverableResponse.ProtoReflect.Descriptor instead.
+func (*CheckDeliverableResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CheckDeliverableResponse) GetDeliverable() bool {
+	if x != nil {
+		return x.Deliverable
+	}
+	return false
+}
+
+func (x *CheckDeliverableResponse) GetDistanceM() float64 {
+	if x != nil {
+		return x.DistanceM
+	}
+	return 0
+}
+
+func (x *CheckDeliverableResponse) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+type GetRestaurantStatusRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId int64                  `protobuf:"varint,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	// Moment to evaluate, now if not set. Used for scheduled orders.
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRestaurantStatusRequest) Reset() {
+	*x = GetRestaurantStatusRequest{}
+	mi := &file_restaurant_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRestaurantStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestaurantStatusRequest) ProtoMessage() {}
+
+func (x *GetRestaurantStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestaurantStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRestaurantStatusRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRestaurantStatusRequest) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+func (x *GetRestaurantStatusRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetRestaurantStatusResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Open   bool                   `protobuf:"varint,1,opt,name=open,proto3" json:"open,omitempty"`
+	Paused bool                   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Why the restaurant is closed, empty if open.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// End of the current opening window, set if open and it closes within two weeks.
+	ClosesAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	// Start of the next opening window, set if closed and it opens within two weeks.
+	NextOpenAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_open_at,json=nextOpenAt,proto3" json:"next_open_at,omitempty"`
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRestaurantStatusResponse) Reset() {
+	*x = GetRestaurantStatusResponse{}
+	mi := &file_restaurant_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRestaurantStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestaurantStatusResponse) ProtoMessage() {}
+
+func (x *GetRestaurantStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestaurantStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetRestaurantStatusResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetRestaurantStatusResponse) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *GetRestaurantStatusResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GetRestaurantStatusResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GetRestaurantStatusResponse) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *GetRestaurantStatusResponse) GetNextOpenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOpenAt
+	}
+	return nil
+}
+
+func (x *GetRestaurantStatusResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type PauseRestaurantRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId int64                  `protobuf:"varint,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	// Not set or in the past resumes the restaurant.
+	PausedUntil   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=paused_until,json=pausedUntil,proto3" json:"paused_until,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseRestaurantRequest) Reset() {
+	*x = PauseRestaurantRequest{}
+	mi := &file_restaurant_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseRestaurantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRestaurantRequest) ProtoMessage() {}
+
+func (x *PauseRestaurantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRestaurantRequest.ProtoReflect.Descriptor instead.
+func (*PauseRestaurantRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PauseRestaurantRequest) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+func (x *PauseRestaurantRequest) GetPausedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedUntil
+	}
+	return nil
+}
+
+func (x *PauseRestaurantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PauseRestaurantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseRestaurantResponse) Reset() {
+	*x = PauseRestaurantResponse{}
+	mi := &file_restaurant_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseRestaurantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRestaurantResponse) ProtoMessage() {}
+
+func (x *PauseRestaurantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRestaurantResponse.ProtoReflect.Descriptor instead.
+func (*PauseRestaurantResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PauseRestaurantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Times of day are local "HH:MM", closes not after opens means the window
+// ends on the next day, e.g. 18:00-02:00, and 00:00-00:00 is the whole day.
+type OpeningHours struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 is Sunday.
+	Weekday       int32  `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	Opens         string `protobuf:"bytes,2,opt,name=opens,proto3" json:"opens,omitempty"`
+	Closes        string `protobuf:"bytes,3,opt,name=closes,proto3" json:"closes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpeningHours) Reset() {
+	*x = OpeningHours{}
+	mi := &file_restaurant_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpeningHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpeningHours) ProtoMessage() {}
+
+func (x *OpeningHours) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpeningHours.ProtoReflect.Descriptor instead.
+func (*OpeningHours) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OpeningHours) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *OpeningHours) GetOpens() string {
+	if x != nil {
+		return x.Opens
+	}
+	return ""
+}
+
+func (x *OpeningHours) GetCloses() string {
+	if x != nil {
+		return x.Closes
+	}
+	return ""
+}
+
+type HolidayHours struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Local date "YYYY-MM-DD".
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Closed all day, opens and closes are ignored.
+	Closed        bool   `protobuf:"varint,2,opt,name=closed,proto3" json:"closed,omitempty"`
+	Opens         string `protobuf:"bytes,3,opt,name=opens,proto3" json:"opens,omitempty"`
+	Closes        string `protobuf:"bytes,4,opt,name=closes,proto3" json:"closes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HolidayHours) Reset() {
+	*x = HolidayHours{}
+	mi := &file_restaurant_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HolidayHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HolidayHours) ProtoMessage() {}
+
+func (x *HolidayHours) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HolidayHours.ProtoReflect.Descriptor instead.
+func (*HolidayHours) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HolidayHours) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *HolidayHours) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *HolidayHours) GetOpens() string {
+	if x != nil {
+		return x.Opens
+	}
+	return ""
+}
+
+func (x *HolidayHours) GetCloses() string {
+	if x != nil {
+		return x.Closes
+	}
+	return ""
+}
+
+type GetOpeningHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId  int64                  `protobuf:"varint,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpeningHoursRequest) Reset() {
+	*x = GetOpeningHoursRequest{}
+	mi := &file_restaurant_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpeningHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpeningHoursRequest) ProtoMessage() {}
+
+func (x *GetOpeningHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpeningHoursRequest.ProtoReflect.Descriptor instead.
+func (*GetOpeningHoursRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetOpeningHoursRequest) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+type GetOpeningHoursResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Timezone string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Weekly   []*OpeningHours        `protobuf:"bytes,2,rep,name=weekly,proto3" json:"weekly,omitempty"`
+	// Holidays from yesterday on.
+	Holidays      []*HolidayHours `protobuf:"bytes,3,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpeningHoursResponse) Reset() {
+	*x = GetOpeningHoursResponse{}
+	mi := &file_restaurant_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpeningHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpeningHoursResponse) ProtoMessage() {}
+
+func (x *GetOpeningHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpeningHoursResponse.ProtoReflect.Descriptor instead.
+func (*GetOpeningHoursResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetOpeningHoursResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetOpeningHoursResponse) GetWeekly() []*OpeningHours {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *GetOpeningHoursResponse) GetHolidays() []*HolidayHours {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type SetOpeningHoursRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId int64                  `protobuf:"varint,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	// IANA name, empty keeps the current one.
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Without weekly hours the restaurant is open around the clock.
+	Weekly []*OpeningHours `protobuf:"bytes,3,rep,name=weekly,proto3" json:"weekly,omitempty"`
+	// Replace all holidays of the restaurant.
+	Holidays      []*HolidayHours `protobuf:"bytes,4,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOpeningHoursRequest) Reset() {
+	*x = SetOpeningHoursRequest{}
+	mi := &file_restaurant_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOpeningHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOpeningHoursRequest) ProtoMessage() {}
+
+func (x *SetOpeningHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOpeningHoursRequest.ProtoReflect.Descriptor instead.
+func (*SetOpeningHoursRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetOpeningHoursRequest) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+func (x *SetOpeningHoursRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SetOpeningHoursRequest) GetWeekly() []*OpeningHours {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *SetOpeningHoursRequest) GetHolidays() []*HolidayHours {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type SetOpeningHoursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOpeningHoursResponse) Reset() {
+	*x = SetOpeningHoursResponse{}
+	mi := &file_restaurant_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOpeningHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOpeningHoursResponse) ProtoMessage() {}
+
+func (x *SetOpeningHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOpeningHoursResponse.ProtoReflect.Descriptor instead.
+func (*SetOpeningHoursResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetOpeningHoursResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 type GetMenuRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RestaurantId  int64                  `protobuf:"varint,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
@@ -31,7 +986,7 @@ type GetMenuRequest struct {
 
 func (x *GetMenuRequest) Reset() {
 	*x = GetMenuRequest{}
-	mi := &file_restaurant_proto_msgTypes[0]
+	mi := &file_restaurant_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -43,7 +998,7 @@ func (x *GetMenuRequest) String() string {
 func (*GetMenuRequest) ProtoMessage() {}
 
 func (x *GetMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[0]
+	mi := &file_restaurant_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,7 +1011,7 @@ func (x *GetMenuRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMenuRequest.ProtoReflect.Descriptor instead.
 func (*GetMenuRequest) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{0}
+	return file_restaurant_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetMenuRequest) GetRestaurantId() int64 {
@@ -75,7 +1030,7 @@ type GetMenuResponse struct {
 
 func (x *GetMenuResponse) Reset() {
 	*x = GetMenuResponse{}
-	mi := &file_restaurant_proto_msgTypes[1]
+	mi := &file_restaurant_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -87,7 +1042,7 @@ func (x *GetMenuResponse) String() string {
 func (*GetMenuResponse) ProtoMessage() {}
 
 func (x *GetMenuResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[1]
+	mi := &file_restaurant_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -100,7 +1055,7 @@ func (x *GetMenuResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMenuResponse.ProtoReflect.Descriptor instead.
 func (*GetMenuResponse) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{1}
+	return file_restaurant_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetMenuResponse) GetItems() []*MenuItem {
@@ -116,13 +1071,14 @@ type MenuItem struct {
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,5,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MenuItem) Reset() {
 	*x = MenuItem{}
-	mi := &file_restaurant_proto_msgTypes[2]
+	mi := &file_restaurant_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -134,7 +1090,7 @@ func (x *MenuItem) String() string {
 func (*MenuItem) ProtoMessage() {}
 
 func (x *MenuItem) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[2]
+	mi := &file_restaurant_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -147,7 +1103,7 @@ func (x *MenuItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuItem.ProtoReflect.Descriptor instead.
 func (*MenuItem) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{2}
+	return file_restaurant_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *MenuItem) GetProductId() int64 {
@@ -178,19 +1134,27 @@ func (x *MenuItem) GetPrice() int64 {
 	return 0
 }
 
+func (x *MenuItem) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
 type UpdateMenuItemRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	RestaurantId   int64                  `protobuf:"varint,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
 	ProductId      int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	NewPrice       *int64                 `protobuf:"varint,3,opt,name=new_price,json=newPrice,proto3,oneof" json:"new_price,omitempty"`
 	NewDescription *string                `protobuf:"bytes,4,opt,name=new_description,json=newDescription,proto3,oneof" json:"new_description,omitempty"`
+	IsAvailable    *bool                  `protobuf:"varint,5,opt,name=is_available,json=isAvailable,proto3,oneof" json:"is_available,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateMenuItemRequest) Reset() {
 	*x = UpdateMenuItemRequest{}
-	mi := &file_restaurant_proto_msgTypes[3]
+	mi := &file_restaurant_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -202,7 +1166,7 @@ func (x *UpdateMenuItemRequest) String() string {
 func (*UpdateMenuItemRequest) ProtoMessage() {}
 
 func (x *UpdateMenuItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[3]
+	mi := &file_restaurant_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +1179,7 @@ func (x *UpdateMenuItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMenuItemRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMenuItemRequest) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{3}
+	return file_restaurant_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateMenuItemRequest) GetRestaurantId() int64 {
@@ -246,6 +1210,13 @@ func (x *UpdateMenuItemRequest) GetNewDescription() string {
 	return ""
 }
 
+func (x *UpdateMenuItemRequest) GetIsAvailable() bool {
+	if x != nil && x.IsAvailable != nil {
+		return *x.IsAvailable
+	}
+	return false
+}
+
 type UpdateMenuItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -256,7 +1227,7 @@ type UpdateMenuItemResponse struct {
 
 func (x *UpdateMenuItemResponse) Reset() {
 	*x = UpdateMenuItemResponse{}
-	mi := &file_restaurant_proto_msgTypes[4]
+	mi := &file_restaurant_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -268,7 +1239,7 @@ func (x *UpdateMenuItemResponse) String() string {
 func (*UpdateMenuItemResponse) ProtoMessage() {}
 
 func (x *UpdateMenuItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[4]
+	mi := &file_restaurant_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,7 +1252,7 @@ func (x *UpdateMenuItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMenuItemResponse.ProtoReflect.Descriptor instead.
 func (*UpdateMenuItemResponse) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{4}
+	return file_restaurant_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateMenuItemResponse) GetSuccess() bool {
@@ -298,37 +1269,468 @@ func (x *UpdateMenuItemResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type KitchenItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitchenItem) Reset() {
+	*x = KitchenItem{}
+	mi := &file_restaurant_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitchenItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitchenItem) ProtoMessage() {}
+
+func (x *KitchenItem) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitchenItem.ProtoReflect.Descriptor instead.
+func (*KitchenItem) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *KitchenItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *KitchenItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type KitchenOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RestaurantId  int64                  `protobuf:"varint,3,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Status        KitchenStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=restaurant_v1.KitchenStatus" json:"status,omitempty"`
+	Items         []*KitchenItem         `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitchenOrder) Reset() {
+	*x = KitchenOrder{}
+	mi := &file_restaurant_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitchenOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitchenOrder) ProtoMessage() {}
+
+func (x *KitchenOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitchenOrder.ProtoReflect.Descriptor instead.
+func (*KitchenOrder) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *KitchenOrder) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KitchenOrder) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *KitchenOrder) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+func (x *KitchenOrder) GetStatus() KitchenStatus {
+	if x != nil {
+		return x.Status
+	}
+	return KitchenStatus_KITCHEN_STATUS_UNSPECIFIED
+}
+
+func (x *KitchenOrder) GetItems() []*KitchenItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *KitchenOrder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListKitchenOrdersRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId int64                  `protobuf:"varint,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	// Unspecified returns tickets that are not ready yet.
+	Status        KitchenStatus `protobuf:"varint,2,opt,name=status,proto3,enum=restaurant_v1.KitchenStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKitchenOrdersRequest) Reset() {
+	*x = ListKitchenOrdersRequest{}
+	mi := &file_restaurant_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKitchenOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKitchenOrdersRequest) ProtoMessage() {}
+
+func (x *ListKitchenOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKitchenOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListKitchenOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListKitchenOrdersRequest) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+func (x *ListKitchenOrdersRequest) GetStatus() KitchenStatus {
+	if x != nil {
+		return x.Status
+	}
+	return KitchenStatus_KITCHEN_STATUS_UNSPECIFIED
+}
+
+type ListKitchenOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*KitchenOrder        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKitchenOrdersResponse) Reset() {
+	*x = ListKitchenOrdersResponse{}
+	mi := &file_restaurant_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKitchenOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKitchenOrdersResponse) ProtoMessage() {}
+
+func (x *ListKitchenOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKitchenOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListKitchenOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListKitchenOrdersResponse) GetOrders() []*KitchenOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type UpdateKitchenOrderStatusRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KitchenOrderId int64                  `protobuf:"varint,1,opt,name=kitchen_order_id,json=kitchenOrderId,proto3" json:"kitchen_order_id,omitempty"`
+	Status         KitchenStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=restaurant_v1.KitchenStatus" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateKitchenOrderStatusRequest) Reset() {
+	*x = UpdateKitchenOrderStatusRequest{}
+	mi := &file_restaurant_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKitchenOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKitchenOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateKitchenOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKitchenOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKitchenOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateKitchenOrderStatusRequest) GetKitchenOrderId() int64 {
+	if x != nil {
+		return x.KitchenOrderId
+	}
+	return 0
+}
+
+func (x *UpdateKitchenOrderStatusRequest) GetStatus() KitchenStatus {
+	if x != nil {
+		return x.Status
+	}
+	return KitchenStatus_KITCHEN_STATUS_UNSPECIFIED
+}
+
+type UpdateKitchenOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *KitchenOrder          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKitchenOrderStatusResponse) Reset() {
+	*x = UpdateKitchenOrderStatusResponse{}
+	mi := &file_restaurant_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKitchenOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKitchenOrderStatusResponse) ProtoMessage() {}
+
+func (x *UpdateKitchenOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKitchenOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateKitchenOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateKitchenOrderStatusResponse) GetOrder() *KitchenOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
 var File_restaurant_proto protoreflect.FileDescriptor
 
 const file_restaurant_proto_rawDesc = "" +
 	"\n" +
-	"\x10restaurant.proto\x12\rrestaurant_v1\x1a\x1fgoogle/protobuf/timestamp.proto\"5\n" +
+	"\x10restaurant.proto\x12\rrestaurant_v1\x1a\x1fgoogle/protobuf/timestamp.proto\";\n" +
+	"\x14GetRestaurantRequest\x12#\n" +
+	"\rrestaurant_id\x18\x01 \x01(\x03R\frestaurantId\"R\n" +
+	"\x15GetRestaurantResponse\x129\n" +
+	"\n" +
+	"restaurant\x18\x01 \x01(\v2\x19.restaurant_v1.RestaurantR\n" +
+	"restaurant\"\xc6\x01\n" +
+	"\n" +
+	"Restaurant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x10\n" +
+	"\x03lat\x18\x04 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x05 \x01(\x01R\x03lng\x12*\n" +
+	"\x11delivery_radius_m\x18\x06 \x01(\x05R\x0fdeliveryRadiusM\x12*\n" +
+	"\x11prep_time_minutes\x18\a \x01(\x05R\x0fprepTimeMinutes\"b\n" +
+	"\x17CheckDeliverableRequest\x12#\n" +
+	"\rrestaurant_id\x18\x01 \x01(\x03R\frestaurantId\x12\x10\n" +
+	"\x03lat\x18\x02 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x03 \x01(\x01R\x03lng\"o\n" +
+	"\x18CheckDeliverableResponse\x12 \n" +
+	"\vdeliverable\x18\x01 \x01(\bR\vdeliverable\x12\x1d\n" +
+	"\n" +
+	"distance_m\x18\x02 \x01(\x01R\tdistanceM\x12\x12\n" +
+	"\x04zone\x18\x03 \x01(\tR\x04zone\"m\n" +
+	"\x1aGetRestaurantStatusRequest\x12#\n" +
+	"\rrestaurant_id\x18\x01 \x01(\x03R\frestaurantId\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\xf4\x01\n" +
+	"\x1bGetRestaurantStatusResponse\x12\x12\n" +
+	"\x04open\x18\x01 \x01(\bR\x04open\x12\x16\n" +
+	"\x06paused\x18\x02 \x01(\bR\x06paused\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x127\n" +
+	"\tcloses_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x12<\n" +
+	"\fnext_open_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"nextOpenAt\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\"\x94\x01\n" +
+	"\x16PauseRestaurantRequest\x12#\n" +
+	"\rrestaurant_id\x18\x01 \x01(\x03R\frestaurantId\x12=\n" +
+	"\fpaused_until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vpausedUntil\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"3\n" +
+	"\x17PauseRestaurantResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"V\n" +
+	"\fOpeningHours\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x14\n" +
+	"\x05opens\x18\x02 \x01(\tR\x05opens\x12\x16\n" +
+	"\x06closes\x18\x03 \x01(\tR\x06closes\"h\n" +
+	"\fHolidayHours\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06closed\x18\x02 \x01(\bR\x06closed\x12\x14\n" +
+	"\x05opens\x18\x03 \x01(\tR\x05opens\x12\x16\n" +
+	"\x06closes\x18\x04 \x01(\tR\x06closes\"=\n" +
+	"\x16GetOpeningHoursRequest\x12#\n" +
+	"\rrestaurant_id\x18\x01 \x01(\x03R\frestaurantId\"\xa3\x01\n" +
+	"\x17GetOpeningHoursResponse\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x123\n" +
+	"\x06weekly\x18\x02 \x03(\v2\x1b.restaurant_v1.OpeningHoursR\x06weekly\x127\n" +
+	"\bholidays\x18\x03 \x03(\v2\x1b.restaurant_v1.HolidayHoursR\bholidays\"\xc7\x01\n" +
+	"\x16SetOpeningHoursRequest\x12#\n" +
+	"\rrestaurant_id\x18\x01 \x01(\x03R\frestaurantId\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x123\n" +
+	"\x06weekly\x18\x03 \x03(\v2\x1b.restaurant_v1.OpeningHoursR\x06weekly\x127\n" +
+	"\bholidays\x18\x04 \x03(\v2\x1b.restaurant_v1.HolidayHoursR\bholidays\"3\n" +
+	"\x17SetOpeningHoursResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"5\n" +
 	"\x0eGetMenuRequest\x12#\n" +
 	"\rrestaurant_id\x18\x01 \x01(\x03R\frestaurantId\"@\n" +
 	"\x0fGetMenuResponse\x12-\n" +
-	"\x05items\x18\x01 \x03(\v2\x17.restaurant_v1.MenuItemR\x05items\"u\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.restaurant_v1.MenuItemR\x05items\"\x98\x01\n" +
 	"\bMenuItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x03R\x05price\"\xcd\x01\n" +
+	"\x05price\x18\x04 \x01(\x03R\x05price\x12!\n" +
+	"\fis_available\x18\x05 \x01(\bR\visAvailable\"\x86\x02\n" +
 	"\x15UpdateMenuItemRequest\x12#\n" +
 	"\rrestaurant_id\x18\x01 \x01(\x03R\frestaurantId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\x03R\tproductId\x12 \n" +
 	"\tnew_price\x18\x03 \x01(\x03H\x00R\bnewPrice\x88\x01\x01\x12,\n" +
-	"\x0fnew_description\x18\x04 \x01(\tH\x01R\x0enewDescription\x88\x01\x01B\f\n" +
+	"\x0fnew_description\x18\x04 \x01(\tH\x01R\x0enewDescription\x88\x01\x01\x12&\n" +
+	"\fis_available\x18\x05 \x01(\bH\x02R\visAvailable\x88\x01\x01B\f\n" +
 	"\n" +
 	"_new_priceB\x12\n" +
-	"\x10_new_description\"m\n" +
+	"\x10_new_descriptionB\x0f\n" +
+	"\r_is_available\"m\n" +
 	"\x16UpdateMenuItemResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x129\n" +
 	"\n" +
-	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt2\xbc\x01\n" +
-	"\x11RestaurantService\x12H\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"H\n" +
+	"\vKitchenItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x81\x02\n" +
+	"\fKitchenOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12#\n" +
+	"\rrestaurant_id\x18\x03 \x01(\x03R\frestaurantId\x124\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1c.restaurant_v1.KitchenStatusR\x06status\x120\n" +
+	"\x05items\x18\x05 \x03(\v2\x1a.restaurant_v1.KitchenItemR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"u\n" +
+	"\x18ListKitchenOrdersRequest\x12#\n" +
+	"\rrestaurant_id\x18\x01 \x01(\x03R\frestaurantId\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.restaurant_v1.KitchenStatusR\x06status\"P\n" +
+	"\x19ListKitchenOrdersResponse\x123\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1b.restaurant_v1.KitchenOrderR\x06orders\"\x81\x01\n" +
+	"\x1fUpdateKitchenOrderStatusRequest\x12(\n" +
+	"\x10kitchen_order_id\x18\x01 \x01(\x03R\x0ekitchenOrderId\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.restaurant_v1.KitchenStatusR\x06status\"U\n" +
+	" UpdateKitchenOrderStatusResponse\x121\n" +
+	"\x05order\x18\x01 \x01(\v2\x1b.restaurant_v1.KitchenOrderR\x05order*\x84\x01\n" +
+	"\rKitchenStatus\x12\x1e\n" +
+	"\x1aKITCHEN_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17KITCHEN_STATUS_ACCEPTED\x10\x01\x12\x1c\n" +
+	"\x18KITCHEN_STATUS_PREPARING\x10\x02\x12\x18\n" +
+	"\x14KITCHEN_STATUS_READY\x10\x032\x91\x06\n" +
+	"\x11RestaurantService\x12Z\n" +
+	"\rGetRestaurant\x12#.restaurant_v1.GetRestaurantRequest\x1a$.restaurant_v1.GetRestaurantResponse\x12c\n" +
+	"\x10CheckDeliverable\x12&.restaurant_v1.CheckDeliverableRequest\x1a'.restaurant_v1.CheckDeliverableResponse\x12l\n" +
+	"\x13GetRestaurantStatus\x12).restaurant_v1.GetRestaurantStatusRequest\x1a*.restaurant_v1.GetRestaurantStatusResponse\x12`\n" +
+	"\x0fPauseRestaurant\x12%.restaurant_v1.PauseRestaurantRequest\x1a&.restaurant_v1.PauseRestaurantResponse\x12`\n" +
+	"\x0fGetOpeningHours\x12%.restaurant_v1.GetOpeningHoursRequest\x1a&.restaurant_v1.GetOpeningHoursResponse\x12`\n" +
+	"\x0fSetOpeningHours\x12%.restaurant_v1.SetOpeningHoursRequest\x1a&.restaurant_v1.SetOpeningHoursResponse\x12H\n" +
 	"\aGetMenu\x12\x1d.restaurant_v1.GetMenuRequest\x1a\x1e.restaurant_v1.GetMenuResponse\x12]\n" +
-	"\x0eUpdateMenuItem\x12$.restaurant_v1.UpdateMenuItemRequest\x1a%.restaurant_v1.UpdateMenuItemResponseBXZVgithub.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1;restaurant_v1b\x06proto3"
+	"\x0eUpdateMenuItem\x12$.restaurant_v1.UpdateMenuItemRequest\x1a%.restaurant_v1.UpdateMenuItemResponse2\xf5\x01\n" +
+	"\x0eKitchenService\x12f\n" +
+	"\x11ListKitchenOrders\x12'.restaurant_v1.ListKitchenOrdersRequest\x1a(.restaurant_v1.ListKitchenOrdersResponse\x12{\n" +
+	"\x18UpdateKitchenOrderStatus\x12..restaurant_v1.UpdateKitchenOrderStatusRequest\x1a/.restaurant_v1.UpdateKitchenOrderStatusResponseBXZVgithub.com/Wuchinator/food-delivery/restaurant-service/pkg/restaurant_v1;restaurant_v1b\x06proto3"
 
 var (
 	file_restaurant_proto_rawDescOnce sync.Once
@@ -342,27 +1744,82 @@ func file_restaurant_proto_rawDescGZIP() []byte {
 	return file_restaurant_proto_rawDescData
 }
 
-var file_restaurant_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_restaurant_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_restaurant_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_restaurant_proto_goTypes = []any{
-	(*GetMenuRequest)(nil),         // 0: restaurant_v1.GetMenuRequest
-	(*GetMenuResponse)(nil),        // 1: restaurant_v1.GetMenuResponse
-	(*MenuItem)(nil),               // 2: restaurant_v1.MenuItem
-	(*UpdateMenuItemRequest)(nil),  // 3: restaurant_v1.UpdateMenuItemRequest
-	(*UpdateMenuItemResponse)(nil), // 4: restaurant_v1.UpdateMenuItemResponse
-	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+	(KitchenStatus)(0),                       // 0: restaurant_v1.KitchenStatus
+	(*GetRestaurantRequest)(nil),             // 1: restaurant_v1.GetRestaurantRequest
+	(*GetRestaurantResponse)(nil),            // 2: restaurant_v1.GetRestaurantResponse
+	(*Restaurant)(nil),                       // 3: restaurant_v1.Restaurant
+	(*CheckDeliverableRequest)(nil),          // 4: restaurant_v1.CheckDeliverableRequest
+	(*CheckDeliverableResponse)(nil),         // 5: restaurant_v1.CheckDeliverableResponse
+	(*GetRestaurantStatusRequest)(nil),       // 6: restaurant_v1.GetRestaurantStatusRequest
+	(*GetRestaurantStatusResponse)(nil),      // 7: restaurant_v1.GetRestaurantStatusResponse
+	(*PauseRestaurantRequest)(nil),           // 8: restaurant_v1.PauseRestaurantRequest
+	(*PauseRestaurantResponse)(nil),          // 9: restaurant_v1.PauseRestaurantResponse
+	(*OpeningHours)(nil),                     // 10: restaurant_v1.OpeningHours
+	(*HolidayHours)(nil),                     // 11: restaurant_v1.HolidayHours
+	(*GetOpeningHoursRequest)(nil),           // 12: restaurant_v1.GetOpeningHoursRequest
+	(*GetOpeningHoursResponse)(nil),          // 13: restaurant_v1.GetOpeningHoursResponse
+	(*SetOpeningHoursRequest)(nil),           // 14: restaurant_v1.SetOpeningHoursRequest
+	(*SetOpeningHoursResponse)(nil),          // 15: restaurant_v1.SetOpeningHoursResponse
+	(*GetMenuRequest)(nil),                   // 16: restaurant_v1.GetMenuRequest
+	(*GetMenuResponse)(nil),                  // 17: restaurant_v1.GetMenuResponse
+	(*MenuItem)(nil),                         // 18: restaurant_v1.MenuItem
+	(*UpdateMenuItemRequest)(nil),            // 19: restaurant_v1.UpdateMenuItemRequest
+	(*UpdateMenuItemResponse)(nil),           // 20: restaurant_v1.UpdateMenuItemResponse
+	(*KitchenItem)(nil),                      // 21: restaurant_v1.KitchenItem
+	(*KitchenOrder)(nil),                     // 22: restaurant_v1.KitchenOrder
+	(*ListKitchenOrdersRequest)(nil),         // 23: restaurant_v1.ListKitchenOrdersRequest
+	(*ListKitchenOrdersResponse)(nil),        // 24: restaurant_v1.ListKitchenOrdersResponse
+	(*UpdateKitchenOrderStatusRequest)(nil),  // 25: restaurant_v1.UpdateKitchenOrderStatusRequest
+	(*UpdateKitchenOrderStatusResponse)(nil), // 26: restaurant_v1.UpdateKitchenOrderStatusResponse
+	(*timestamppb.Timestamp)(nil),            // 27: google.protobuf.Timestamp
 }
 var file_restaurant_proto_depIdxs = []int32{
-	2, // 0: restaurant_v1.GetMenuResponse.items:type_name -> restaurant_v1.MenuItem
-	5, // 1: restaurant_v1.UpdateMenuItemResponse.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: restaurant_v1.RestaurantService.GetMenu:input_type -> restaurant_v1.GetMenuRequest
-	3, // 3: restaurant_v1.RestaurantService.UpdateMenuItem:input_type -> restaurant_v1.UpdateMenuItemRequest
-	1, // 4: restaurant_v1.RestaurantService.GetMenu:output_type -> restaurant_v1.GetMenuResponse
-	4, // 5: restaurant_v1.RestaurantService.UpdateMenuItem:output_type -> restaurant_v1.UpdateMenuItemResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3,  // 0: restaurant_v1.GetRestaurantResponse.restaurant:type_name -> restaurant_v1.Restaurant
+	27, // 1: restaurant_v1.GetRestaurantStatusRequest.at:type_name -> google.protobuf.Timestamp
+	27, // 2: restaurant_v1.GetRestaurantStatusResponse.closes_at:type_name -> google.protobuf.Timestamp
+	27, // 3: restaurant_v1.GetRestaurantStatusResponse.next_open_at:type_name -> google.protobuf.Timestamp
+	27, // 4: restaurant_v1.PauseRestaurantRequest.paused_until:type_name -> google.protobuf.Timestamp
+	10, // 5: restaurant_v1.GetOpeningHoursResponse.weekly:type_name -> restaurant_v1.OpeningHours
+	11, // 6: restaurant_v1.GetOpeningHoursResponse.holidays:type_name -> restaurant_v1.HolidayHours
+	10, // 7: restaurant_v1.SetOpeningHoursRequest.weekly:type_name -> restaurant_v1.OpeningHours
+	11, // 8: restaurant_v1.SetOpeningHoursRequest.holidays:type_name -> restaurant_v1.HolidayHours
+	18, // 9: restaurant_v1.GetMenuResponse.items:type_name -> restaurant_v1.MenuItem
+	27, // 10: restaurant_v1.UpdateMenuItemResponse.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: restaurant_v1.KitchenOrder.status:type_name -> restaurant_v1.KitchenStatus
+	21, // 12: restaurant_v1.KitchenOrder.items:type_name -> restaurant_v1.KitchenItem
+	27, // 13: restaurant_v1.KitchenOrder.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 14: restaurant_v1.ListKitchenOrdersRequest.status:type_name -> restaurant_v1.KitchenStatus
+	22, // 15: restaurant_v1.ListKitchenOrdersResponse.orders:type_name -> restaurant_v1.KitchenOrder
+	0,  // 16: restaurant_v1.UpdateKitchenOrderStatusRequest.status:type_name -> restaurant_v1.KitchenStatus
+	22, // 17: restaurant_v1.UpdateKitchenOrderStatusResponse.order:type_name -> restaurant_v1.KitchenOrder
+	1,  // 18: restaurant_v1.RestaurantService.GetRestaurant:input_type -> restaurant_v1.GetRestaurantRequest
+	4,  // 19: restaurant_v1.RestaurantService.CheckDeliverable:input_type -> restaurant_v1.CheckDeliverableRequest
+	6,  // 20: restaurant_v1.RestaurantService.GetRestaurantStatus:input_type -> restaurant_v1.GetRestaurantStatusRequest
+	8,  // 21: restaurant_v1.RestaurantService.PauseRestaurant:input_type -> restaurant_v1.PauseRestaurantRequest
+	12, // 22: restaurant_v1.RestaurantService.GetOpeningHours:input_type -> restaurant_v1.GetOpeningHoursRequest
+	14, // 23: restaurant_v1.RestaurantService.SetOpeningHours:input_type -> restaurant_v1.SetOpeningHoursRequest
+	16, // 24: restaurant_v1.RestaurantService.GetMenu:input_type -> restaurant_v1.GetMenuRequest
+	19, // 25: restaurant_v1.RestaurantService.UpdateMenuItem:input_type -> restaurant_v1.UpdateMenuItemRequest
+	23, // 26: restaurant_v1.KitchenService.ListKitchenOrders:input_type -> restaurant_v1.ListKitchenOrdersRequest
+	25, // 27: restaurant_v1.KitchenService.UpdateKitchenOrderStatus:input_type -> restaurant_v1.UpdateKitchenOrderStatusRequest
+	2,  // 28: restaurant_v1.RestaurantService.GetRestaurant:output_type -> restaurant_v1.GetRestaurantResponse
+	5,  // 29: restaurant_v1.RestaurantService.CheckDeliverable:output_type -> restaurant_v1.CheckDeliverableResponse
+	7,  // 30: restaurant_v1.RestaurantService.GetRestaurantStatus:output_type -> restaurant_v1.GetRestaurantStatusResponse
+	9,  // 31: restaurant_v1.RestaurantService.PauseRestaurant:output_type -> restaurant_v1.PauseRestaurantResponse
+	13, // 32: restaurant_v1.RestaurantService.GetOpeningHours:output_type -> restaurant_v1.GetOpeningHoursResponse
+	15, // 33: restaurant_v1.RestaurantService.SetOpeningHours:output_type -> restaurant_v1.SetOpeningHoursResponse
+	17, // 34: restaurant_v1.RestaurantService.GetMenu:output_type -> restaurant_v1.GetMenuResponse
+	20, // 35: restaurant_v1.RestaurantService.UpdateMenuItem:output_type -> restaurant_v1.UpdateMenuItemResponse
+	24, // 36: restaurant_v1.KitchenService.ListKitchenOrders:output_type -> restaurant_v1.ListKitchenOrdersResponse
+	26, // 37: restaurant_v1.KitchenService.UpdateKitchenOrderStatus:output_type -> restaurant_v1.UpdateKitchenOrderStatusResponse
+	28, // [28:38] is the sub-list for method output_type
+	18, // [18:28] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_restaurant_proto_init() }
@@ -370,19 +1827,20 @@ func file_restaurant_proto_init() {
 	if File_restaurant_proto != nil {
 		return
 	}
-	file_restaurant_proto_msgTypes[3].OneofWrappers = []any{}
+	file_restaurant_proto_msgTypes[18].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_restaurant_proto_rawDesc), len(file_restaurant_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   26,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_restaurant_proto_goTypes,
 		DependencyIndexes: file_restaurant_proto_depIdxs,
+		EnumInfos:         file_restaurant_proto_enumTypes,
 		MessageInfos:      file_restaurant_proto_msgTypes,
 	}.Build()
 	File_restaurant_proto = out.File
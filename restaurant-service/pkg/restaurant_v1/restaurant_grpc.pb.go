@@ -19,14 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RestaurantService_GetMenu_FullMethodName        = "/restaurant_v1.RestaurantService/GetMenu"
-	RestaurantService_UpdateMenuItem_FullMethodName = "/restaurant_v1.RestaurantService/UpdateMenuItem"
+	RestaurantService_GetRestaurant_FullMethodName       = "/restaurant_v1.RestaurantService/GetRestaurant"
+	RestaurantService_CheckDeliverable_FullMethodName    = "/restaurant_v1.RestaurantService/CheckDeliverable"
+	RestaurantService_GetRestaurantStatus_FullMethodName = "/restaurant_v1.RestaurantService/GetRestaurantStatus"
+	RestaurantService_PauseRestaurant_FullMethodName     = "/restaurant_v1.RestaurantService/PauseRestaurant"
+	RestaurantService_GetOpeningHours_FullMethodName     = "/restaurant_v1.RestaurantService/GetOpeningHours"
+	RestaurantService_SetOpeningHours_FullMethodName     = "/restaurant_v1.RestaurantService/SetOpeningHours"
+	RestaurantService_GetMenu_FullMethodName             = "/restaurant_v1.RestaurantService/GetMenu"
+	RestaurantService_UpdateMenuItem_FullMethodName      = "/restaurant_v1.RestaurantService/UpdateMenuItem"
 )
 
 // RestaurantServiceClient is the client API for RestaurantService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RestaurantServiceClient interface {
+	GetRestaurant(ctx context.Context, in *GetRestaurantRequest, opts ...grpc.CallOption) (*GetRestaurantResponse, error)
+	CheckDeliverable(ctx context.Context, in *CheckDeliverableRequest, opts ...grpc.CallOption) (*CheckDeliverableResponse, error)
+	GetRestaurantStatus(ctx context.Context, in *GetRestaurantStatusRequest, opts ...grpc.CallOption) (*GetRestaurantStatusResponse, error)
+	PauseRestaurant(ctx context.Context, in *PauseRestaurantRequest, opts ...grpc.CallOption) (*PauseRestaurantResponse, error)
+	GetOpeningHours(ctx context.Context, in *GetOpeningHoursRequest, opts ...grpc.CallOption) (*GetOpeningHoursResponse, error)
+	// SetOpeningHours replaces the timetable of the restaurant.
+	SetOpeningHours(ctx context.Context, in *SetOpeningHoursRequest, opts ...grpc.CallOption) (*SetOpeningHoursResponse, error)
 	GetMenu(ctx context.Context, in *GetMenuRequest, opts ...grpc.CallOption) (*GetMenuResponse, error)
 	UpdateMenuItem(ctx context.Context, in *UpdateMenuItemRequest, opts ...grpc.CallOption) (*UpdateMenuItemResponse, error)
 }
@@ -39,6 +52,66 @@ func NewRestaurantServiceClient(cc grpc.ClientConnInterface) RestaurantServiceCl
 	return &restaurantServiceClient{cc}
 }
 
+func (c *restaurantServiceClient) GetRestaurant(ctx context.Context, in *GetRestaurantRequest, opts ...grpc.CallOption) (*GetRestaurantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRestaurantResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_GetRestaurant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) CheckDeliverable(ctx context.Context, in *CheckDeliverableRequest, opts ...grpc.CallOption) (*CheckDeliverableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckDeliverableResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_CheckDeliverable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) GetRestaurantStatus(ctx context.Context, in *GetRestaurantStatusRequest, opts ...grpc.CallOption) (*GetRestaurantStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRestaurantStatusResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_GetRestaurantStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) PauseRestaurant(ctx context.Context, in *PauseRestaurantRequest, opts ...grpc.CallOption) (*PauseRestaurantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseRestaurantResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_PauseRestaurant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) GetOpeningHours(ctx context.Context, in *GetOpeningHoursRequest, opts ...grpc.CallOption) (*GetOpeningHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOpeningHoursResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_GetOpeningHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) SetOpeningHours(ctx context.Context, in *SetOpeningHoursRequest, opts ...grpc.CallOption) (*SetOpeningHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOpeningHoursResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_SetOpeningHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *restaurantServiceClient) GetMenu(ctx context.Context, in *GetMenuRequest, opts ...grpc.CallOption) (*GetMenuResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMenuResponse)
@@ -63,6 +136,13 @@ func (c *restaurantServiceClient) UpdateMenuItem(ctx context.Context, in *Update
 // All implementations must embed UnimplementedRestaurantServiceServer
 // for forward compatibility.
 type RestaurantServiceServer interface {
+	GetRestaurant(context.Context, *GetRestaurantRequest) (*GetRestaurantResponse, error)
+	CheckDeliverable(context.Context, *CheckDeliverableRequest) (*CheckDeliverableResponse, error)
+	GetRestaurantStatus(context.Context, *GetRestaurantStatusRequest) (*GetRestaurantStatusResponse, error)
+	PauseRestaurant(context.Context, *PauseRestaurantRequest) (*PauseRestaurantResponse, error)
+	GetOpeningHours(context.Context, *GetOpeningHoursRequest) (*GetOpeningHoursResponse, error)
+	// SetOpeningHours replaces the timetable of the restaurant.
+	SetOpeningHours(context.Context, *SetOpeningHoursRequest) (*SetOpeningHoursResponse, error)
 	GetMenu(context.Context, *GetMenuRequest) (*GetMenuResponse, error)
 	UpdateMenuItem(context.Context, *UpdateMenuItemRequest) (*UpdateMenuItemResponse, error)
 	mustEmbedUnimplementedRestaurantServiceServer()
@@ -75,6 +155,24 @@ type RestaurantServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedRestaurantServiceServer struct{}
 
+func (UnimplementedRestaurantServiceServer) GetRestaurant(context.Context, *GetRestaurantRequest) (*GetRestaurantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRestaurant not implemented")
+}
+func (UnimplementedRestaurantServiceServer) CheckDeliverable(context.Context, *CheckDeliverableRequest) (*CheckDeliverableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckDeliverable not implemented")
+}
+func (UnimplementedRestaurantServiceServer) GetRestaurantStatus(context.Context, *GetRestaurantStatusRequest) (*GetRestaurantStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRestaurantStatus not implemented")
+}
+func (UnimplementedRestaurantServiceServer) PauseRestaurant(context.Context, *PauseRestaurantRequest) (*PauseRestaurantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseRestaurant not implemented")
+}
+func (UnimplementedRestaurantServiceServer) GetOpeningHours(context.Context, *GetOpeningHoursRequest) (*GetOpeningHoursResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOpeningHours not implemented")
+}
+func (UnimplementedRestaurantServiceServer) SetOpeningHours(context.Context, *SetOpeningHoursRequest) (*SetOpeningHoursResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetOpeningHours not implemented")
+}
 func (UnimplementedRestaurantServiceServer) GetMenu(context.Context, *GetMenuRequest) (*GetMenuResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMenu not implemented")
 }
@@ -102,6 +200,114 @@ func RegisterRestaurantServiceServer(s grpc.ServiceRegistrar, srv RestaurantServ
 	s.RegisterService(&RestaurantService_ServiceDesc, srv)
 }
 
+func _RestaurantService_GetRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRestaurantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).GetRestaurant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_GetRestaurant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).GetRestaurant(ctx, req.(*GetRestaurantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_CheckDeliverable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDeliverableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).CheckDeliverable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_CheckDeliverable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).CheckDeliverable(ctx, req.(*CheckDeliverableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_GetRestaurantStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRestaurantStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).GetRestaurantStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_GetRestaurantStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).GetRestaurantStatus(ctx, req.(*GetRestaurantStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_PauseRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRestaurantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).PauseRestaurant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_PauseRestaurant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).PauseRestaurant(ctx, req.(*PauseRestaurantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_GetOpeningHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpeningHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).GetOpeningHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_GetOpeningHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).GetOpeningHours(ctx, req.(*GetOpeningHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_SetOpeningHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOpeningHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).SetOpeningHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_SetOpeningHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).SetOpeningHours(ctx, req.(*SetOpeningHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RestaurantService_GetMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMenuRequest)
 	if err := dec(in); err != nil {
@@ -145,6 +351,30 @@ var RestaurantService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "restaurant_v1.RestaurantService",
 	HandlerType: (*RestaurantServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRestaurant",
+			Handler:    _RestaurantService_GetRestaurant_Handler,
+		},
+		{
+			MethodName: "CheckDeliverable",
+			Handler:    _RestaurantService_CheckDeliverable_Handler,
+		},
+		{
+			MethodName: "GetRestaurantStatus",
+			Handler:    _RestaurantService_GetRestaurantStatus_Handler,
+		},
+		{
+			MethodName: "PauseRestaurant",
+			Handler:    _RestaurantService_PauseRestaurant_Handler,
+		},
+		{
+			MethodName: "GetOpeningHours",
+			Handler:    _RestaurantService_GetOpeningHours_Handler,
+		},
+		{
+			MethodName: "SetOpeningHours",
+			Handler:    _RestaurantService_SetOpeningHours_Handler,
+		},
 		{
 			MethodName: "GetMenu",
 			Handler:    _RestaurantService_GetMenu_Handler,
@@ -157,3 +387,143 @@ var RestaurantService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "restaurant.proto",
 }
+
+const (
+	KitchenService_ListKitchenOrders_FullMethodName        = "/restaurant_v1.KitchenService/ListKitchenOrders"
+	KitchenService_UpdateKitchenOrderStatus_FullMethodName = "/restaurant_v1.KitchenService/UpdateKitchenOrderStatus"
+)
+
+// KitchenServiceClient is the client API for KitchenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KitchenServiceClient interface {
+	ListKitchenOrders(ctx context.Context, in *ListKitchenOrdersRequest, opts ...grpc.CallOption) (*ListKitchenOrdersResponse, error)
+	UpdateKitchenOrderStatus(ctx context.Context, in *UpdateKitchenOrderStatusRequest, opts ...grpc.CallOption) (*UpdateKitchenOrderStatusResponse, error)
+}
+
+type kitchenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKitchenServiceClient(cc grpc.ClientConnInterface) KitchenServiceClient {
+	return &kitchenServiceClient{cc}
+}
+
+func (c *kitchenServiceClient) ListKitchenOrders(ctx context.Context, in *ListKitchenOrdersRequest, opts ...grpc.CallOption) (*ListKitchenOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKitchenOrdersResponse)
+	err := c.cc.Invoke(ctx, KitchenService_ListKitchenOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitchenServiceClient) UpdateKitchenOrderStatus(ctx context.Context, in *UpdateKitchenOrderStatusRequest, opts ...grpc.CallOption) (*UpdateKitchenOrderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateKitchenOrderStatusResponse)
+	err := c.cc.Invoke(ctx, KitchenService_UpdateKitchenOrderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KitchenServiceServer is the server API for KitchenService service.
+// All implementations must embed UnimplementedKitchenServiceServer
+// for forward compatibility.
+type KitchenServiceServer interface {
+	ListKitchenOrders(context.Context, *ListKitchenOrdersRequest) (*ListKitchenOrdersResponse, error)
+	UpdateKitchenOrderStatus(context.Context, *UpdateKitchenOrderStatusRequest) (*UpdateKitchenOrderStatusResponse, error)
+	mustEmbedUnimplementedKitchenServiceServer()
+}
+
+// UnimplementedKitchenServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKitchenServiceServer struct{}
+
+func (UnimplementedKitchenServiceServer) ListKitchenOrders(context.Context, *ListKitchenOrdersRequest) (*ListKitchenOrdersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListKitchenOrders not implemented")
+}
+func (UnimplementedKitchenServiceServer) UpdateKitchenOrderStatus(context.Context, *UpdateKitchenOrderStatusRequest) (*UpdateKitchenOrderStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateKitchenOrderStatus not implemented")
+}
+func (UnimplementedKitchenServiceServer) mustEmbedUnimplementedKitchenServiceServer() {}
+func (UnimplementedKitchenServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeKitchenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KitchenServiceServer will
+// result in compilation errors.
+type UnsafeKitchenServiceServer interface {
+	mustEmbedUnimplementedKitchenServiceServer()
+}
+
+func RegisterKitchenServiceServer(s grpc.ServiceRegistrar, srv KitchenServiceServer) {
+	// If the following call panics, it indicates UnimplementedKitchenServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KitchenService_ServiceDesc, srv)
+}
+
+func _KitchenService_ListKitchenOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKitchenOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitchenServiceServer).ListKitchenOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitchenService_ListKitchenOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitchenServiceServer).ListKitchenOrders(ctx, req.(*ListKitchenOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitchenService_UpdateKitchenOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKitchenOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitchenServiceServer).UpdateKitchenOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitchenService_UpdateKitchenOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitchenServiceServer).UpdateKitchenOrderStatus(ctx, req.(*UpdateKitchenOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KitchenService_ServiceDesc is the grpc.ServiceDesc for KitchenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KitchenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "restaurant_v1.KitchenService",
+	HandlerType: (*KitchenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListKitchenOrders",
+			Handler:    _KitchenService_ListKitchenOrders_Handler,
+		},
+		{
+			MethodName: "UpdateKitchenOrderStatus",
+			Handler:    _KitchenService_UpdateKitchenOrderStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "restaurant.proto",
+}